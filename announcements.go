@@ -0,0 +1,364 @@
+package steamcommunity
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrorAnnouncementUnidentified = errors.New("steamcommunity: Posted announcement could not be identified")
+
+var (
+	announcementIDRegexp   = regexp.MustCompile(`/announcements/detail/(\d+)`)
+	announcementLinkRegexp = regexp.MustCompile(`(?s)<a [^>]*href="[^"]*/announcements/detail/(\d+)"[^>]*>(.*?)</a>`)
+)
+
+// announcementLanguages maps Steam language codes to the language IDs used in announcement forms.
+var announcementLanguages = map[string]int{
+	"english":    0,
+	"german":     1,
+	"french":     2,
+	"italian":    3,
+	"koreana":    4,
+	"spanish":    5,
+	"schinese":   6,
+	"tchinese":   7,
+	"russian":    8,
+	"thai":       9,
+	"japanese":   10,
+	"portuguese": 11,
+	"polish":     12,
+	"danish":     13,
+	"dutch":      14,
+	"finnish":    15,
+	"norwegian":  16,
+	"swedish":    17,
+	"hungarian":  18,
+	"czech":      19,
+	"romanian":   20,
+	"turkish":    21,
+	"brazilian":  22,
+	"bulgarian":  23,
+	"greek":      24,
+	"arabic":     25,
+	"ukrainian":  26,
+	"latam":      27,
+	"vietnamese": 28,
+}
+
+// Announcement is a Steam Group announcement.
+type Announcement struct {
+	ID       string
+	Headline string
+	// Body is BBCode when posting, and the rendered HTML when listing announcements.
+	Body   string
+	Author string
+	Date   time.Time
+	URL    string
+
+	// Localized contains translations of the announcement, keyed by Steam language code (such as "german").
+	// Headline and Body are used for English unless Localized contains "english".
+	// When listing announcements, languages without a translation are left out.
+	Localized map[string]AnnouncementText
+}
+
+// AnnouncementText is the headline and body of an announcement in a single language.
+type AnnouncementText struct {
+	Headline string
+	Body     string
+}
+
+type announcementFeed struct {
+	Items []announcementItem `xml:"channel>item"`
+}
+
+type announcementItem struct {
+	Title       string `xml:"title"`
+	Description string `xml:"description"`
+	Link        string `xml:"link"`
+	PubDate     string `xml:"pubDate"`
+	Author      string `xml:"author"`
+}
+
+// Announcements retrieves the group's most recent announcements from its RSS feed.
+// languages specifies Steam language codes (such as "german") to retrieve translations for, which are added to Localized.
+// Steam's feed falls back to the English text for announcements without a translation, so those are not added.
+func (g *Group) Announcements(languages ...string) ([]*Announcement, error) {
+	announcements, err := g.announcementFeed("")
+
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*Announcement, len(announcements))
+	for _, announcement := range announcements {
+		byID[announcement.ID] = announcement
+	}
+
+	for _, language := range languages {
+		if _, ok := announcementLanguages[language]; !ok {
+			return nil, fmt.Errorf("steamcommunity: Unknown language %q", language)
+		}
+
+		localized, err := g.announcementFeed(language)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range localized {
+			announcement, ok := byID[l.ID]
+
+			if !ok || (l.Headline == announcement.Headline && l.Body == announcement.Body) {
+				continue
+			}
+
+			if announcement.Localized == nil {
+				announcement.Localized = make(map[string]AnnouncementText)
+			}
+
+			announcement.Localized[language] = AnnouncementText{Headline: l.Headline, Body: l.Body}
+		}
+	}
+
+	return announcements, nil
+}
+
+// announcementFeed retrieves the group's RSS feed, in the given language if it is not empty.
+func (g *Group) announcementFeed(language string) ([]*Announcement, error) {
+	uri := fmt.Sprintf("https://steamcommunity.com/gid/%s/rss/", g.ID)
+	if language != "" {
+		uri += "?l=" + url.QueryEscape(language)
+	}
+
+	resp, err := g.client.get(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var feed announcementFeed
+	err = xml.Unmarshal(body, &feed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	announcements := make([]*Announcement, 0, len(feed.Items))
+	for _, item := range feed.Items {
+		announcement := &Announcement{
+			Headline: item.Title,
+			Body:     item.Description,
+			Author:   item.Author,
+			URL:      item.Link,
+		}
+
+		// Items that don't link to an announcement can't be edited or matched with their translations.
+		match := announcementIDRegexp.FindStringSubmatch(item.Link)
+
+		if match == nil {
+			continue
+		}
+
+		announcement.ID = match[1]
+
+		announcement.Date, _ = time.Parse(time.RFC1123Z, item.PubDate)
+
+		announcements = append(announcements, announcement)
+	}
+
+	return announcements, nil
+}
+
+// PostAnnouncement sends a request to create a new Steam Group announcement.
+// headline specifies the headline of the announcement.
+// content specifies the content of the announcement.
+// The returned announcement contains the ID and URL of the new announcement.
+func (g *Group) PostAnnouncement(headline string, content string) (*Announcement, error) {
+	return g.PostLocalizedAnnouncement(&Announcement{Headline: headline, Body: content})
+}
+
+// PostLocalizedAnnouncement creates a new announcement with a translation for each language in announcement.Localized.
+// The returned announcement contains the ID and URL of the new announcement.
+// If the announcement was posted but can't be told apart from others on the listing, the returned announcement
+// has no ID, and the error is ErrorAnnouncementUnidentified.
+func (g *Group) PostLocalizedAnnouncement(announcement *Announcement) (*Announcement, error) {
+	form, err := announcement.form(false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	form["sessionID"] = g.client.SessionID
+	form["action"] = "post"
+
+	uri := fmt.Sprintf("https://steamcommunity.com/gid/%s/announcements", g.ID)
+
+	// Remember the newest announcement already listed, as headlines aren't unique.
+	listing, err := g.client.getPage(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var newest uint64
+	for _, match := range announcementIDRegexp.FindAllStringSubmatch(listing, -1) {
+		if id, _ := strconv.ParseUint(match[1], 10, 64); id > newest {
+			newest = id
+		}
+	}
+
+	resp, err := g.client.postForm(uri, map[string]string{}, form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// Steam redirects to the announcement listing. Announcement IDs increase over time, so the new announcement
+	// is newer than any listed before, unlike older ones moving up from the next page.
+	body, _ := ioutil.ReadAll(resp.Body)
+	headline := strings.TrimSpace(form["headline"])
+
+	seen := make(map[string]bool)
+	var candidates []string
+	for _, match := range announcementLinkRegexp.FindAllStringSubmatch(string(body), -1) {
+		id, _ := strconv.ParseUint(match[1], 10, 64)
+
+		if id <= newest || seen[match[1]] || (headline != "" && strings.TrimSpace(htmlToText(match[2])) != headline) {
+			continue
+		}
+
+		seen[match[1]] = true
+		candidates = append(candidates, match[1])
+	}
+
+	posted := *announcement
+
+	if len(candidates) != 1 {
+		return &posted, ErrorAnnouncementUnidentified
+	}
+
+	posted.ID = candidates[0]
+	posted.URL = g.announcementURL(posted.ID)
+
+	return &posted, nil
+}
+
+// EditAnnouncement replaces the headline and content of an existing announcement.
+func (g *Group) EditAnnouncement(id string, headline string, content string) error {
+	return g.EditLocalizedAnnouncement(&Announcement{ID: id, Headline: headline, Body: content})
+}
+
+// EditLocalizedAnnouncement replaces the headline and content of the announcement with the ID announcement.ID,
+// in every language in announcement.Localized.
+func (g *Group) EditLocalizedAnnouncement(announcement *Announcement) error {
+	form, err := announcement.form(true)
+
+	if err != nil {
+		return err
+	}
+
+	form["sessionID"] = g.client.SessionID
+	form["gid"] = announcement.ID
+	form["action"] = "update"
+
+	resp, err := g.client.postForm(
+		fmt.Sprintf("https://steamcommunity.com/gid/%s/announcements", g.ID),
+		map[string]string{},
+		form,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// DeleteAnnouncement deletes an announcement.
+func (g *Group) DeleteAnnouncement(id string) error {
+	resp, err := g.client.get(
+		fmt.Sprintf(
+			"https://steamcommunity.com/gid/%s/announcements/delete/%s?sessionID=%s",
+			g.ID,
+			id,
+			url.QueryEscape(g.client.SessionID),
+		),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+func (g *Group) announcementURL(id string) string {
+	return fmt.Sprintf("https://steamcommunity.com/gid/%s/announcements/detail/%s", g.ID, id)
+}
+
+// form returns the headline and body fields for every language of the announcement.
+func (a *Announcement) form(update bool) (map[string]string, error) {
+	texts := make(map[string]AnnouncementText, len(a.Localized)+1)
+	for language, text := range a.Localized {
+		texts[language] = text
+	}
+
+	if _, ok := texts["english"]; !ok && a.Headline != "" {
+		texts["english"] = AnnouncementText{Headline: a.Headline, Body: a.Body}
+	}
+
+	if len(texts) == 0 {
+		return nil, errors.New("steamcommunity: Announcement has no headline")
+	}
+
+	form := map[string]string{}
+	for language, text := range texts {
+		id, ok := announcementLanguages[language]
+
+		if !ok {
+			return nil, fmt.Errorf("steamcommunity: Unknown language %q", language)
+		}
+
+		form[fmt.Sprintf("languages[%d][headline]", id)] = text.Headline
+		form[fmt.Sprintf("languages[%d][body]", id)] = text.Body
+
+		if update {
+			form[fmt.Sprintf("languages[%d][updated]", id)] = "1"
+		}
+	}
+
+	// The top level fields are shown to languages without a translation.
+	if english, ok := texts["english"]; ok {
+		form["headline"] = english.Headline
+		form["body"] = english.Body
+	} else {
+		form["headline"] = a.Headline
+		form["body"] = a.Body
+	}
+
+	return form, nil
+}
@@ -0,0 +1,282 @@
+package steamcommunity_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestAnnouncements() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// RSS request.
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "/gid/103582791454641428/rss/", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<?xml version="1.0" encoding="UTF-8" ?>
+				<rss version="2.0">
+					<channel>
+						<title>shival</title>
+						<item>
+							<title>Patch notes</title>
+							<description><![CDATA[Fixed <b>everything</b>.]]></description>
+							<link>https://steamcommunity.com/groups/shival/announcements/detail/1234567890123456789</link>
+							<pubDate>Mon, 14 Nov 2016 10:30:00 +0000</pubDate>
+							<author>Alex</author>
+						</item>
+					</channel>
+				</rss>
+			`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	announcements, err := group.Announcements()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), announcements, 1)
+	assert.Equal(s.T(), "1234567890123456789", announcements[0].ID)
+	assert.Equal(s.T(), "Patch notes", announcements[0].Headline)
+	assert.Equal(s.T(), "Fixed <b>everything</b>.", announcements[0].Body)
+	assert.Equal(s.T(), "Alex", announcements[0].Author)
+	assert.True(s.T(), time.Date(2016, time.November, 14, 10, 30, 0, 0, time.UTC).Equal(announcements[0].Date))
+}
+
+func (s *ClientTestSuite) TestLocalizedAnnouncements() {
+	feed := func(items string) func(w http.ResponseWriter, r *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8" ?><rss version="2.0"><channel>` + items + `</channel></rss>`))
+		}
+	}
+
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// RSS request.
+		feed(`
+			<item>
+				<title>Patch notes</title>
+				<description>Fixed everything.</description>
+				<link>https://steamcommunity.com/groups/shival/announcements/detail/1234567890123456789</link>
+			</item>
+			<item>
+				<title>Server rules</title>
+				<description>Be nice.</description>
+				<link>https://steamcommunity.com/groups/shival/announcements/detail/1111111111111111111</link>
+			</item>
+			<item>
+				<title>Not an announcement</title>
+				<link>https://steamcommunity.com/groups/shival</link>
+			</item>
+		`),
+
+		// German RSS request, where the rules aren't translated.
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "german", r.URL.Query().Get("l"))
+			feed(`
+				<item>
+					<title>Patchnotizen</title>
+					<description>Alles behoben.</description>
+					<link>https://steamcommunity.com/groups/shival/announcements/detail/1234567890123456789</link>
+				</item>
+				<item>
+					<title>Server rules</title>
+					<description>Be nice.</description>
+					<link>https://steamcommunity.com/groups/shival/announcements/detail/1111111111111111111</link>
+				</item>
+			`)(w, r)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	announcements, err := group.Announcements("german")
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), announcements, 2)
+	assert.Equal(s.T(), map[string]steamcommunity.AnnouncementText{
+		"german": {Headline: "Patchnotizen", Body: "Alles behoben."},
+	}, announcements[0].Localized)
+	assert.Nil(s.T(), announcements[1].Localized)
+}
+
+// announcementListingResponse serves a group's announcement listing, linking to each ID with its headline.
+func announcementListingResponse(announcements ...[2]string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		for _, announcement := range announcements {
+			fmt.Fprintf(w, `<div class="announcement">
+				<a class="large_title" href="https://steamcommunity.com/groups/shival/announcements/detail/%s">%s</a>
+			</div>`, announcement[0], announcement[1])
+		}
+	}
+}
+
+func (s *ClientTestSuite) TestPostAnnouncement() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		announcementListingResponse(
+			[2]string{"1000000000000000001", "the rules"},
+			[2]string{"1111111111111111111", "Patch notes"},
+		),
+
+		// Announcement request, which redirects to the listing.
+		announcementListingResponse(
+			[2]string{"1000000000000000001", "the rules"},
+			[2]string{"1234567890123456789", "Patch notes"},
+			[2]string{"1111111111111111111", "Patch notes"},
+		),
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	announcement, err := group.PostAnnouncement("Patch notes", "Fixed everything.")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "post", s.lastForm().Get("action"))
+	assert.Equal(s.T(), "1234567890123456789", announcement.ID)
+	assert.Equal(s.T(), "https://steamcommunity.com/gid/103582791454641428/announcements/detail/1234567890123456789", announcement.URL)
+}
+
+func (s *ClientTestSuite) TestPostAnnouncementUnidentified() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		announcementListingResponse([2]string{"1111111111111111111", "Patch notes"}),
+
+		// The new announcement isn't on the listing, but one from the next page is.
+		announcementListingResponse(
+			[2]string{"1111111111111111111", "Patch notes"},
+			[2]string{"1000000000000000000", "Patch notes"},
+		),
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	announcement, err := group.PostAnnouncement("Patch notes", "Fixed everything.")
+
+	assert.Equal(s.T(), steamcommunity.ErrorAnnouncementUnidentified, err)
+	assert.Equal(s.T(), "Patch notes", announcement.Headline)
+	assert.Empty(s.T(), announcement.ID)
+}
+
+func (s *ClientTestSuite) TestPostLocalizedAnnouncement() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		announcementListingResponse(),
+
+		// Announcement request.
+		announcementListingResponse([2]string{"1234567890123456789", "Patch notes"}),
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	announcement, err := group.PostLocalizedAnnouncement(&steamcommunity.Announcement{
+		Headline: "Patch notes",
+		Body:     "Fixed everything.",
+		Localized: map[string]steamcommunity.AnnouncementText{
+			"german": {Headline: "Patchnotizen", Body: "Alles behoben."},
+		},
+	})
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "1234567890123456789", announcement.ID)
+	assert.Equal(s.T(), "Patch notes", form.Get("headline"))
+	assert.Equal(s.T(), "Patch notes", form.Get("languages[0][headline]"))
+	assert.Equal(s.T(), "Fixed everything.", form.Get("languages[0][body]"))
+	assert.Equal(s.T(), "Patchnotizen", form.Get("languages[1][headline]"))
+	assert.Equal(s.T(), "Alles behoben.", form.Get("languages[1][body]"))
+}
+
+func (s *ClientTestSuite) TestPostLocalizedAnnouncementWithoutEnglish() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		announcementListingResponse([2]string{"1111111111111111111", "Server rules"}),
+
+		// Announcement request. The listing shows the new announcement in German.
+		announcementListingResponse(
+			[2]string{"1234567890123456789", "Patchnotizen"},
+			[2]string{"1111111111111111111", "Server rules"},
+		),
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	announcement, err := group.PostLocalizedAnnouncement(&steamcommunity.Announcement{
+		Localized: map[string]steamcommunity.AnnouncementText{
+			"german": {Headline: "Patchnotizen", Body: "Alles behoben."},
+		},
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "1234567890123456789", announcement.ID)
+}
+
+func (s *ClientTestSuite) TestEditAnnouncement() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Announcement request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	err = group.EditAnnouncement("1234567890123456789", "Patch notes", "Fixed everything, again.")
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/gid/103582791454641428/announcements", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "update", form.Get("action"))
+	assert.Equal(s.T(), "1234567890123456789", form.Get("gid"))
+	assert.Equal(s.T(), s.Client.SessionID, form.Get("sessionID"))
+	assert.Equal(s.T(), "Patch notes", form.Get("headline"))
+	assert.Equal(s.T(), "Fixed everything, again.", form.Get("body"))
+	assert.Equal(s.T(), "Fixed everything, again.", form.Get("languages[0][body]"))
+	assert.Equal(s.T(), "1", form.Get("languages[0][updated]"))
+}
+
+func (s *ClientTestSuite) TestDeleteAnnouncement() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Delete request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	err = group.DeleteAnnouncement("1234567890123456789")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/gid/103582791454641428/announcements/delete/1234567890123456789", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), s.Client.SessionID, s.LastRequest.URL.Query().Get("sessionID"))
+}
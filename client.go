@@ -1,6 +1,8 @@
 package steamcommunity
 
 import (
+	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
@@ -8,9 +10,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"math/big"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -31,6 +35,7 @@ var (
 	ErrorMobileAuth    = errors.New("steamcommunity: SteamGuard mobile auth required")
 	ErrorCaptcha       = errors.New("steamcommunity: CAPTCHA input required")
 	ErrorUnknown       = errors.New("steamcommunity: Unknown error")
+	ErrorNotLoggedIn   = errors.New("steamcommunity: Not logged in")
 )
 
 type Client struct {
@@ -40,8 +45,12 @@ type Client struct {
 	OAuthToken   string
 	Cookies      []*http.Cookie
 
+	// APIKey is a Steam Web API key, used by the methods that can use the Web API instead of scraping.
+	APIKey string
+
 	client     *http.Client
 	captchaGID string
+	profiles   profileCache
 }
 
 type loginResponse struct {
@@ -297,16 +306,115 @@ func (c *Client) setCookies(cookies []*http.Cookie, secure bool) {
 	}
 }
 
+// timeLocation returns the timezone Steam renders times in for this client, as set by the timezoneOffset cookie.
+// If the cookie is not set, it is set to UTC.
+// An offset of 0 returns time.UTC rather than an unnamed zone with no offset, so that parsed times are
+// reported as UTC, and compare equal to times built with time.UTC.
+func (c *Client) timeLocation() *time.Location {
+	for _, cookie := range c.client.Jar.Cookies(&url.URL{Scheme: "https", Host: "steamcommunity.com"}) {
+		if cookie.Name == "timezoneOffset" {
+			value := strings.SplitN(strings.Trim(cookie.Value, `"`), ",", 2)[0]
+			if offset, err := strconv.Atoi(value); err == nil && offset != 0 {
+				return time.FixedZone("", offset)
+			} else if err == nil {
+				return time.UTC
+			}
+		}
+	}
+
+	c.setCookie(&http.Cookie{Name: "timezoneOffset", Value: "0,0"}, true)
+
+	return time.UTC
+}
+
 func (c *Client) get(uri string) (*http.Response, error) {
 	return c.client.Get(uri)
 }
 
+// getWithTimezone is like get, but asks Steam to render times with the given UTC offset in seconds.
+// The client's own timezoneOffset cookie is left unchanged, so concurrent requests are unaffected.
+func (c *Client) getWithTimezone(uri string, offset int) (*http.Response, error) {
+	client := *c.client
+	client.Jar = timezoneJar{CookieJar: c.client.Jar, value: fmt.Sprintf("%d,0", offset)}
+
+	return client.Get(uri)
+}
+
+// timezoneJar overrides the timezoneOffset cookie of the jar it wraps.
+type timezoneJar struct {
+	http.CookieJar
+	value string
+}
+
+func (j timezoneJar) Cookies(u *url.URL) []*http.Cookie {
+	cookies := []*http.Cookie{{Name: "timezoneOffset", Value: j.value}}
+	for _, cookie := range j.CookieJar.Cookies(u) {
+		if cookie.Name != "timezoneOffset" {
+			cookies = append(cookies, cookie)
+		}
+	}
+
+	return cookies
+}
+
+func (c *Client) getContext(ctx context.Context, uri string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", uri, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(req.WithContext(ctx))
+}
+
+// getPage retrieves the body of a page, returning an error if Steam responded with a failure status code.
+func (c *Client) getPage(uri string) (string, error) {
+	return c.getPageContext(context.Background(), uri)
+}
+
+// getPageContext is like getPage, but the request is cancelled when ctx is done.
+func (c *Client) getPageContext(ctx context.Context, uri string) (string, error) {
+	resp, err := c.getContext(ctx, uri)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return "", err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+
+	return string(body), err
+}
+
+// checkResponse returns an error if Steam responded to an action with a failure status code.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode == 403 {
+		return ErrorNotLoggedIn
+	}
+
+	if resp.StatusCode != 200 {
+		return ErrorUnknown
+	}
+
+	return nil
+}
+
 func (c *Client) postForm(uri string, headers map[string]string, form map[string]string) (*http.Response, error) {
 	values := url.Values{}
 	for k, v := range form {
 		values.Add(k, v)
 	}
 
+	return c.postValues(uri, headers, values)
+}
+
+// postValues is like postForm, but allows fields with multiple values.
+func (c *Client) postValues(uri string, headers map[string]string, values url.Values) (*http.Response, error) {
 	req, err := http.NewRequest("POST", uri, strings.NewReader(values.Encode()))
 
 	if err != nil {
@@ -321,3 +429,68 @@ func (c *Client) postForm(uri string, headers map[string]string, form map[string
 
 	return c.client.Do(req)
 }
+
+// postMultipart posts fields and the contents of file as a multipart form, as used for file uploads.
+func (c *Client) postMultipart(uri string, fields map[string]string, fileField string, fileName string, file io.Reader) (*http.Response, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for k, v := range fields {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := writer.CreateFormFile(fileField, fileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.Copy(part, file); err != nil {
+		return nil, err
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", uri, &buf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return c.client.Do(req)
+}
+
+// postFormJSON posts form to uri and unmarshals the JSON response into v.
+func (c *Client) postFormJSON(uri string, form map[string]string, v interface{}) error {
+	values := url.Values{}
+	for k, val := range form {
+		values.Add(k, val)
+	}
+
+	return c.postValuesJSON(uri, values, v)
+}
+
+// postValuesJSON is like postFormJSON, but allows fields with multiple values.
+func (c *Client) postValuesJSON(uri string, values url.Values, v interface{}) error {
+	resp, err := c.postValues(uri, map[string]string{}, values)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,189 @@
+package steamcommunity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// CommentThreadType is the type of object a comment thread belongs to.
+type CommentThreadType string
+
+const (
+	CommentThreadProfile       CommentThreadType = "Profile"
+	CommentThreadClan          CommentThreadType = "Clan"
+	CommentThreadPublishedFile CommentThreadType = "PublishedFile_Public"
+	CommentThreadForumTopic    CommentThreadType = "ForumTopic"
+)
+
+// DefaultCommentsPerPage is the number of comments Steam shows per page of a comment thread.
+const DefaultCommentsPerPage = 6
+
+var (
+	commentRegexp         = regexp.MustCompile(`<div class="commentthread_comment[^"]*" id="comment_(\d+)"`)
+	commentAuthorRegexp   = regexp.MustCompile(`(?s)<a class="[^"]*commentthread_author_link[^"]*" href="([^"]+)" data-miniprofile="(\d+)"[^>]*>(.*?)</a>`)
+	commentTimeRegexp     = regexp.MustCompile(`class="commentthread_comment_timestamp"[^>]*data-timestamp="(\d+)"`)
+	commentContentsRegexp = regexp.MustCompile(`(?s)id="comment_content_\d+">(.*?)</div>`)
+)
+
+// CommentThread is the comments on a profile, group, workshop item or screenshot.
+type CommentThread struct {
+	Type    CommentThreadType
+	OwnerID string
+	// ThreadID is the ID of the object within its owner, or "-1" for profiles and groups.
+	ThreadID string
+
+	// feature2 is the topic ID for forum topics.
+	feature2 string
+	client   *Client
+}
+
+// Comment is a single comment in a comment thread.
+type Comment struct {
+	ID               string
+	AuthorSteamID    string
+	AuthorName       string
+	AuthorProfileURL string
+	Time             time.Time
+	HTML             string
+	Text             string
+}
+
+// CommentPage is a page of comments from a comment thread, newest first.
+type CommentPage struct {
+	Comments []*Comment
+	Start    int
+	Total    int
+}
+
+type commentResponse struct {
+	Success      steamBool `json:"success"`
+	Error        string    `json:"error"`
+	Start        int       `json:"start"`
+	TotalCount   int       `json:"total_count"`
+	CommentsHTML string    `json:"comments_html"`
+}
+
+// CommentThread returns the comment thread on the group's page.
+func (g *Group) CommentThread() *CommentThread {
+	return &CommentThread{Type: CommentThreadClan, OwnerID: g.ID, ThreadID: "-1", client: g.client}
+}
+
+// ProfileCommentThread returns the comment thread on the profile of the given SteamID64.
+func (c *Client) ProfileCommentThread(steamID string) *CommentThread {
+	return &CommentThread{Type: CommentThreadProfile, OwnerID: steamID, ThreadID: "-1", client: c}
+}
+
+// PublishedFileCommentThread returns the comment thread on a workshop item or screenshot.
+// ownerSteamID is the SteamID64 of the file's creator.
+func (c *Client) PublishedFileCommentThread(ownerSteamID string, fileID string) *CommentThread {
+	return &CommentThread{Type: CommentThreadPublishedFile, OwnerID: ownerSteamID, ThreadID: fileID, client: c}
+}
+
+// Comments retrieves count comments, skipping the newest start comments.
+func (t *CommentThread) Comments(start int, count int) (*CommentPage, error) {
+	commentResp, err := t.action("render", map[string]string{
+		"start": strconv.Itoa(start),
+		"count": strconv.Itoa(count),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommentPage{
+		Comments: parseComments(commentResp.CommentsHTML),
+		Start:    commentResp.Start,
+		Total:    commentResp.TotalCount,
+	}, nil
+}
+
+// Post posts a new comment to the thread.
+func (t *CommentThread) Post(text string) error {
+	_, err := t.action("post", map[string]string{
+		"comment": text,
+		"count":   strconv.Itoa(DefaultCommentsPerPage),
+	})
+
+	return err
+}
+
+// Delete deletes a comment from the thread.
+func (t *CommentThread) Delete(commentID string) error {
+	_, err := t.action("delete", map[string]string{
+		"gidcomment": commentID,
+		"start":      "0",
+		"count":      strconv.Itoa(DefaultCommentsPerPage),
+	})
+
+	return err
+}
+
+// action sends a request to one of the comment thread endpoints.
+func (t *CommentThread) action(action string, form map[string]string) (*commentResponse, error) {
+	form["sessionid"] = t.client.SessionID
+	form["feature2"] = "-1"
+	if t.feature2 != "" {
+		form["feature2"] = t.feature2
+	}
+
+	var commentResp commentResponse
+	err := t.client.postFormJSON(
+		fmt.Sprintf("https://steamcommunity.com/comment/%s/%s/%s/%s/", t.Type, action, t.OwnerID, t.ThreadID),
+		form,
+		&commentResp,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !commentResp.Success {
+		if commentResp.Error != "" {
+			return nil, fmt.Errorf("steamcommunity: %s", commentResp.Error)
+		}
+
+		return nil, errors.New("steamcommunity: Comment request failed")
+	}
+
+	return &commentResp, nil
+}
+
+// parseComments parses the comments in the HTML of a comment thread.
+func parseComments(page string) []*Comment {
+	var comments []*Comment
+
+	indices := commentRegexp.FindAllStringSubmatchIndex(page, -1)
+	for i, index := range indices {
+		end := len(page)
+		if i+1 < len(indices) {
+			end = indices[i+1][0]
+		}
+
+		block := page[index[0]:end]
+		comment := &Comment{ID: page[index[2]:index[3]]}
+
+		if match := commentAuthorRegexp.FindStringSubmatch(block); match != nil {
+			accountID, _ := strconv.ParseUint(match[2], 10, 32)
+			comment.AuthorProfileURL = match[1]
+			comment.AuthorSteamID = accountIDToSteamID(uint32(accountID))
+			comment.AuthorName = htmlToText(match[3])
+		}
+
+		if match := commentTimeRegexp.FindStringSubmatch(block); match != nil {
+			timestamp, _ := strconv.ParseInt(match[1], 10, 64)
+			comment.Time = time.Unix(timestamp, 0)
+		}
+
+		if match := commentContentsRegexp.FindStringSubmatch(block); match != nil {
+			comment.HTML = match[1]
+			comment.Text = htmlToText(match[1])
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return comments
+}
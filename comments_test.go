@@ -0,0 +1,85 @@
+package steamcommunity_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// commentsResponse serves a page of two comments.
+func commentsResponse(w http.ResponseWriter, r *http.Request) {
+	html := `
+		<div class="commentthread_comment responsive_body_text" id="comment_1500000000000000002">
+			<div class="commentthread_comment_content">
+				<div class="commentthread_comment_author">
+					<a class="hoverunderline commentthread_author_link" href="https://steamcommunity.com/profiles/76561198333828103" data-miniprofile="373562375"><bdi>Sam</bdi></a>
+					<span class="commentthread_comment_timestamp" title="14 November, 2016" data-timestamp="1479119400">Nov 14, 2016 @ 10:30am</span>
+				</div>
+				<div class="commentthread_comment_text" id="comment_content_1500000000000000002">
+					FREE SKINS <a class="bb_link" href="https://steamcommunity.com/linkfilter/?url=http://free-skins.example">http://free-skins.example</a>
+				</div>
+			</div>
+		</div>
+		<div class="commentthread_comment responsive_body_text" id="comment_1500000000000000001">
+			<div class="commentthread_comment_content">
+				<div class="commentthread_comment_author">
+					<a class="hoverunderline commentthread_author_link" href="https://steamcommunity.com/id/alex" data-miniprofile="103542307"><bdi>Alex</bdi></a>
+					<span class="commentthread_comment_timestamp" title="13 November, 2016" data-timestamp="1479033000">Nov 13, 2016 @ 10:30am</span>
+				</div>
+				<div class="commentthread_comment_text" id="comment_content_1500000000000000001">
+					Server is down&lt;br&gt;again<br>please fix
+				</div>
+			</div>
+		</div>
+	`
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"success":       true,
+		"start":         0,
+		"pagesize":      6,
+		"total_count":   2,
+		"comments_html": html,
+	})
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func (s *ClientTestSuite) TestGroupComments() {
+	s.login(groupResponse, groupResponse, commentsResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	page, err := group.CommentThread().Comments(0, 6)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/comment/Clan/render/103582791454641428/-1/", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), 2, page.Total)
+	assert.Len(s.T(), page.Comments, 2)
+
+	comment := page.Comments[1]
+	assert.Equal(s.T(), "1500000000000000001", comment.ID)
+	assert.Equal(s.T(), "76561198063808035", comment.AuthorSteamID)
+	assert.Equal(s.T(), "Alex", comment.AuthorName)
+	assert.Equal(s.T(), "https://steamcommunity.com/id/alex", comment.AuthorProfileURL)
+	assert.Equal(s.T(), time.Unix(1479033000, 0), comment.Time)
+	assert.Equal(s.T(), "Server is down<br>again\nplease fix", comment.Text)
+}
+
+func (s *ClientTestSuite) TestPostCommentFailure() {
+	s.login(
+		// Post request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":false,"error":"You've been posting too frequently."}`))
+		},
+	)
+
+	err := s.Client.ProfileCommentThread("76561198333828103").Post("Hello")
+
+	assert.EqualError(s.T(), err, "steamcommunity: You've been posting too frequently.")
+	assert.Equal(s.T(), "Hello", s.lastForm().Get("comment"))
+}
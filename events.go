@@ -0,0 +1,239 @@
+package steamcommunity
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// GroupEventType is the type of a Steam Group event.
+type GroupEventType string
+
+const (
+	GroupEventGame         GroupEventType = "GameEvent"
+	GroupEventChat         GroupEventType = "ChatEvent"
+	GroupEventParty        GroupEventType = "PartyEvent"
+	GroupEventMeeting      GroupEventType = "MeetingEvent"
+	GroupEventBroadcast    GroupEventType = "BroadcastEvent"
+	GroupEventSpecialCause GroupEventType = "SpecialCauseEvent"
+	GroupEventMusicAndArts GroupEventType = "MusicAndArtsEvent"
+	GroupEventSports       GroupEventType = "SportsEvent"
+	GroupEventTrip         GroupEventType = "TripEvent"
+	GroupEventOther        GroupEventType = "OtherEvent"
+)
+
+var (
+	eventIDRegexp     = regexp.MustCompile(`id="(\d+)_eventBlock"`)
+	eventTitleRegexp  = regexp.MustCompile(`(?s)<a class="headlineLink" href="([^"]+)"[^>]*>(.*?)</a>`)
+	eventDayRegexp    = regexp.MustCompile(`(?s)class="eventDateBlock">\s*<span>[^<]*?(\d{1,2})[^<]*</span>`)
+	eventTimeRegexp   = regexp.MustCompile(`class="eventDateTime">([^<]+)<`)
+	eventTypeRegexp   = regexp.MustCompile(`/([A-Za-z]+Event)\.png`)
+	eventAppRegexp    = regexp.MustCompile(`steamcommunity\.com/app/(\d+)`)
+	eventServerRegexp = regexp.MustCompile(`steam://connect/([^/"]+)(?:/([^"]*))?`)
+	eventNotesRegexp  = regexp.MustCompile(`(?s)<div class="eventBlockNotes">(.*?)</div>`)
+)
+
+// GroupEvent is a scheduled Steam Group event.
+type GroupEvent struct {
+	ID             string
+	Name           string
+	Type           GroupEventType
+	AppID          int
+	ServerIP       string
+	ServerPassword string
+	// Start is the time the event starts. A zero Start schedules the event to start immediately.
+	Start       time.Time
+	Description string
+	URL         string
+}
+
+type eventFeed struct {
+	Results       string   `xml:"results"`
+	Events        []string `xml:"event"`
+	ExpiredEvents []string `xml:"expiredEvent"`
+}
+
+// Events retrieves the group's events in the month containing month.
+// Event times are returned in month's location.
+func (g *Group) Events(month time.Time) ([]*GroupEvent, error) {
+	// Steam renders event times with a single UTC offset for the whole month, so use the offset at its start.
+	// Times after a daylight saving change in the month are converted back to month's location once parsed.
+	_, offset := month.Zone()
+
+	resp, err := g.client.getWithTimezone(
+		fmt.Sprintf(
+			"https://steamcommunity.com/gid/%s/events?xml=1&action=eventFeed&month=%d&year=%d",
+			g.ID,
+			int(month.Month()),
+			month.Year(),
+		),
+		offset,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var feed eventFeed
+	err = xml.Unmarshal(body, &feed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if feed.Results != "OK" {
+		return nil, fmt.Errorf("steamcommunity: %s", feed.Results)
+	}
+
+	var events []*GroupEvent
+	for _, block := range append(feed.ExpiredEvents, feed.Events...) {
+		event, err := parseEventBlock(block, month.Year(), month.Month(), time.FixedZone("", offset), month.Location())
+
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// CreateEvent schedules a new event. event.ID is ignored.
+func (g *Group) CreateEvent(event *GroupEvent) error {
+	form := event.form()
+	form["action"] = "newEvent"
+
+	return g.postEventForm(form)
+}
+
+// EditEvent replaces the details of the event with the ID event.ID.
+func (g *Group) EditEvent(event *GroupEvent) error {
+	form := event.form()
+	form["action"] = "updateEvent"
+	form["eventID"] = event.ID
+
+	return g.postEventForm(form)
+}
+
+// DeleteEvent deletes an event.
+func (g *Group) DeleteEvent(id string) error {
+	return g.postEventForm(map[string]string{
+		"action":  "deleteEvent",
+		"eventID": id,
+	})
+}
+
+func (g *Group) postEventForm(form map[string]string) error {
+	form["sessionid"] = g.client.SessionID
+
+	resp, err := g.client.postForm(
+		fmt.Sprintf("https://steamcommunity.com/gid/%s/eventEdit", g.ID),
+		map[string]string{},
+		form,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// form returns the fields shared by creating and editing an event.
+func (e *GroupEvent) form() map[string]string {
+	eventType := e.Type
+	if eventType == "" {
+		eventType = GroupEventOther
+	}
+
+	form := map[string]string{
+		"name":           e.Name,
+		"type":           string(eventType),
+		"serverIP":       e.ServerIP,
+		"serverPassword": e.ServerPassword,
+		"notes":          e.Description,
+	}
+
+	if e.AppID != 0 {
+		form["appID"] = strconv.Itoa(e.AppID)
+	}
+
+	if e.Start.IsZero() {
+		form["eventQuickTime"] = "now"
+		return form
+	}
+
+	// Steam takes the start time as a 12 hour clock time in the given timezone offset.
+	_, offset := e.Start.Zone()
+	form["tzOffset"] = strconv.Itoa(offset)
+	form["timeChoice"] = "specific"
+	form["startDate"] = e.Start.Format("01/02/06")
+	form["startHour"] = e.Start.Format("3")
+	form["startMinute"] = e.Start.Format("04")
+	form["startAMPM"] = e.Start.Format("PM")
+
+	return form
+}
+
+// parseEventBlock parses an event from the HTML of the event feed.
+// Times are read in zone, the fixed offset Steam rendered them with, and returned in loc.
+func parseEventBlock(block string, year int, month time.Month, zone *time.Location, loc *time.Location) (*GroupEvent, error) {
+	event := &GroupEvent{Type: GroupEventOther}
+
+	match := eventIDRegexp.FindStringSubmatch(block)
+	if match == nil {
+		return nil, errors.New("steamcommunity: Malformed event feed")
+	}
+	event.ID = match[1]
+
+	if match := eventTitleRegexp.FindStringSubmatch(block); match != nil {
+		event.URL = match[1]
+		event.Name = htmlToText(match[2])
+	}
+
+	if match := eventTypeRegexp.FindStringSubmatch(block); match != nil {
+		event.Type = GroupEventType(match[1])
+	}
+
+	if match := eventAppRegexp.FindStringSubmatch(block); match != nil {
+		event.AppID, _ = strconv.Atoi(match[1])
+	}
+
+	if match := eventServerRegexp.FindStringSubmatch(block); match != nil {
+		event.ServerIP = match[1]
+		event.ServerPassword = match[2]
+	}
+
+	if match := eventNotesRegexp.FindStringSubmatch(block); match != nil {
+		event.Description = htmlToText(match[1])
+	}
+
+	if match := eventDayRegexp.FindStringSubmatch(block); match != nil {
+		day, _ := strconv.Atoi(match[1])
+		hour, minute := 0, 0
+
+		if match := eventTimeRegexp.FindStringSubmatch(block); match != nil {
+			if clock, err := time.Parse("3:04pm", htmlToText(match[1])); err == nil {
+				hour, minute = clock.Hour(), clock.Minute()
+			}
+		}
+
+		event.Start = time.Date(year, month, day, hour, minute, 0, 0, zone).In(loc)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,149 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+	_ "time/tzdata"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// eventFeedResponse serves an event feed containing a single game night.
+func eventFeedResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+		<response>
+			<results><![CDATA[OK]]></results>
+			<monthName><![CDATA[November]]></monthName>
+			<year><![CDATA[2016]]></year>
+			<event><![CDATA[
+				<div class="eventBlock" id="3167148133464014123_eventBlock">
+					<div class="eventDateBlock">
+						<span>Friday 18</span><br>
+						<span class="eventDateTime">8:30pm</span>
+					</div>
+					<img src="https://steamcommunity-a.akamaihd.net/public/images/events/GameEvent.png">
+					<div class="eventBlockTitle">
+						<a class="headlineLink" href="https://steamcommunity.com/groups/shival/events/3167148133464014123">Game night &amp; chill</a>
+					</div>
+					<a href="https://steamcommunity.com/app/440">Team Fortress 2</a>
+					<a href="steam://connect/203.0.113.5:27015/hunter2">Join server</a>
+					<div class="eventBlockNotes">Bring friends!</div>
+				</div>
+			]]></event>
+		</response>
+	`))
+}
+
+func (s *ClientTestSuite) TestEvents() {
+	s.login(groupResponse, groupResponse, eventFeedResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	loc := time.FixedZone("AEDT", 11*60*60)
+	events, err := group.Events(time.Date(2016, time.November, 1, 0, 0, 0, 0, loc))
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "11", s.LastRequest.URL.Query().Get("month"))
+	assert.Equal(s.T(), []*steamcommunity.GroupEvent{{
+		ID:             "3167148133464014123",
+		Name:           "Game night & chill",
+		Type:           steamcommunity.GroupEventGame,
+		AppID:          440,
+		ServerIP:       "203.0.113.5:27015",
+		ServerPassword: "hunter2",
+		Start:          time.Date(2016, time.November, 18, 20, 30, 0, 0, loc),
+		Description:    "Bring friends!",
+		URL:            "https://steamcommunity.com/groups/shival/events/3167148133464014123",
+	}}, events)
+}
+
+func (s *ClientTestSuite) TestEventsDaylightSaving() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<response>
+					<results><![CDATA[OK]]></results>
+					<event><![CDATA[
+						<div class="eventBlock" id="1_eventBlock">
+							<div class="eventDateBlock">
+								<span>Friday 21</span><br>
+								<span class="eventDateTime">8:30pm</span>
+							</div>
+						</div>
+					]]></event>
+				</response>
+			`))
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"notifications":{}}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	// Daylight saving starts in Sydney on October 2, so Steam renders the whole month at +10:00.
+	loc, err := time.LoadLocation("Australia/Sydney")
+	s.Require().NoError(err)
+
+	events, err := group.Events(time.Date(2016, time.October, 1, 0, 0, 0, 0, loc))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), time.Date(2016, time.October, 21, 10, 30, 0, 0, time.UTC), events[0].Start.UTC())
+	assert.Equal(s.T(), loc, events[0].Start.Location())
+
+	cookie, err := s.LastRequest.Cookie("timezoneOffset")
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "36000,0", cookie.Value)
+
+	// The offset is only sent with the event feed request.
+	_, err = s.Client.GetNotifications()
+	assert.NoError(s.T(), err)
+
+	for _, cookie := range s.LastRequest.Cookies() {
+		assert.NotEqual(s.T(), "36000,0", cookie.Value)
+	}
+}
+
+func (s *ClientTestSuite) TestCreateEvent() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Event request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	err = group.CreateEvent(&steamcommunity.GroupEvent{
+		Name:     "Game night",
+		Type:     steamcommunity.GroupEventGame,
+		AppID:    440,
+		ServerIP: "203.0.113.5:27015",
+		Start:    time.Date(2016, time.November, 18, 20, 30, 0, 0, time.FixedZone("AEDT", 11*60*60)),
+	})
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/gid/103582791454641428/eventEdit", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "newEvent", form.Get("action"))
+	assert.Equal(s.T(), "GameEvent", form.Get("type"))
+	assert.Equal(s.T(), "440", form.Get("appID"))
+	assert.Equal(s.T(), "39600", form.Get("tzOffset"))
+	assert.Equal(s.T(), "11/18/16", form.Get("startDate"))
+	assert.Equal(s.T(), "8", form.Get("startHour"))
+	assert.Equal(s.T(), "30", form.Get("startMinute"))
+	assert.Equal(s.T(), "PM", form.Get("startAMPM"))
+}
@@ -0,0 +1,295 @@
+package steamcommunity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TopicPostsPerPage is the number of replies Steam shows per page of a forum topic.
+const TopicPostsPerPage = 15
+
+var (
+	forumRegexp           = regexp.MustCompile(`(?s)data-gidforum="(\d+)".*?<a class="forum_list_name" href="([^"]+)"[^>]*>(.*?)</a>`)
+	topicRegexp           = regexp.MustCompile(`<div class="forum_topic[ "][^>]*data-gidforumtopic="(\d+)"`)
+	topicClassRegexp      = regexp.MustCompile(`^<div class="([^"]*)"`)
+	topicURLRegexp        = regexp.MustCompile(`class="forum_topic_overlay" href="([^"]+)"`)
+	topicNameRegexp       = regexp.MustCompile(`(?s)class="forum_topic_name[^"]*">(.*?)</div>`)
+	topicAuthorRegexp     = regexp.MustCompile(`(?s)class="forum_topic_op"[^>]*data-miniprofile="(\d+)"[^>]*>(.*?)</div>`)
+	topicReplyCountRegexp = regexp.MustCompile(`(?s)class="forum_topic_reply_count">\s*([\d,]+)`)
+	topicLastPostRegexp   = regexp.MustCompile(`class="forum_topic_lastpost"[^>]*data-timestamp="(\d+)"`)
+	topicLockedRegexp     = regexp.MustCompile(`class="forum_topic_locked"`)
+)
+
+// Forum is a discussion forum of a Steam Group.
+type Forum struct {
+	ID   string
+	Name string
+	URL  string
+
+	group *Group
+}
+
+// Topic is a topic in a group discussion forum.
+type Topic struct {
+	ID            string
+	Title         string
+	AuthorSteamID string
+	AuthorName    string
+	Replies       int
+	LastPost      time.Time
+	Sticky        bool
+	Locked        bool
+	URL           string
+
+	forum *Forum
+}
+
+type createTopicResponse struct {
+	Success  steamBool `json:"success"`
+	TopicID  string    `json:"gidforumtopic"`
+	TopicURL string    `json:"topic_url"`
+	Error    string    `json:"error"`
+}
+
+type moderateTopicResponse struct {
+	Success steamBool `json:"success"`
+}
+
+// Forums retrieves the group's discussion forums.
+func (g *Group) Forums() ([]*Forum, error) {
+	body, err := g.client.getPage(fmt.Sprintf("https://steamcommunity.com/gid/%s/discussions", g.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var forums []*Forum
+	for _, match := range forumRegexp.FindAllStringSubmatch(body, -1) {
+		forums = append(forums, &Forum{
+			ID:    match[1],
+			URL:   match[2],
+			Name:  htmlToText(match[3]),
+			group: g,
+		})
+	}
+
+	return forums, nil
+}
+
+// Topics retrieves a page of the forum's topics, starting at page 1.
+func (f *Forum) Topics(page int) ([]*Topic, error) {
+	body, err := f.group.client.getPage(fmt.Sprintf("%s?fp=%d", f.URL, page))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var topics []*Topic
+
+	indices := topicRegexp.FindAllStringSubmatchIndex(body, -1)
+	for i, index := range indices {
+		end := len(body)
+		if i+1 < len(indices) {
+			end = indices[i+1][0]
+		}
+
+		block := body[index[0]:end]
+		topic := &Topic{ID: body[index[2]:index[3]], forum: f}
+
+		if match := topicClassRegexp.FindStringSubmatch(block); match != nil {
+			topic.Sticky = strings.Contains(" "+match[1]+" ", " sticky ")
+		}
+
+		topic.Locked = topicLockedRegexp.MatchString(block)
+
+		if match := topicURLRegexp.FindStringSubmatch(block); match != nil {
+			topic.URL = match[1]
+		}
+
+		if match := topicNameRegexp.FindStringSubmatch(block); match != nil {
+			topic.Title = htmlToText(match[1])
+		}
+
+		if match := topicAuthorRegexp.FindStringSubmatch(block); match != nil {
+			accountID, _ := strconv.ParseUint(match[1], 10, 32)
+			topic.AuthorSteamID = accountIDToSteamID(uint32(accountID))
+			topic.AuthorName = htmlToText(match[2])
+		}
+
+		if match := topicReplyCountRegexp.FindStringSubmatch(block); match != nil {
+			topic.Replies, _ = strconv.Atoi(strings.Replace(match[1], ",", "", -1))
+		}
+
+		if match := topicLastPostRegexp.FindStringSubmatch(block); match != nil {
+			timestamp, _ := strconv.ParseInt(match[1], 10, 64)
+			topic.LastPost = time.Unix(timestamp, 0)
+		}
+
+		topics = append(topics, topic)
+	}
+
+	return topics, nil
+}
+
+// CreateTopic creates a new topic in the forum.
+func (f *Forum) CreateTopic(title string, text string) (*Topic, error) {
+	var topicResp createTopicResponse
+	err := f.group.client.postFormJSON(
+		fmt.Sprintf("https://steamcommunity.com/forum/%s/%s/createtopic/", f.group.ID, f.ID),
+		map[string]string{
+			"sessionid": f.group.client.SessionID,
+			"topic":     title,
+			"text":      text,
+		},
+		&topicResp,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !topicResp.Success {
+		if topicResp.Error != "" {
+			return nil, fmt.Errorf("steamcommunity: %s", topicResp.Error)
+		}
+
+		return nil, errors.New("steamcommunity: Failed to create topic")
+	}
+
+	return &Topic{
+		ID:            topicResp.TopicID,
+		Title:         title,
+		AuthorSteamID: f.group.client.SteamID,
+		URL:           topicResp.TopicURL,
+		forum:         f,
+	}, nil
+}
+
+// CommentThread returns the replies to the topic as a comment thread.
+func (t *Topic) CommentThread() *CommentThread {
+	return &CommentThread{
+		Type:     CommentThreadForumTopic,
+		OwnerID:  t.forum.group.ID,
+		ThreadID: t.forum.ID,
+		feature2: t.ID,
+		client:   t.forum.group.client,
+	}
+}
+
+// Posts retrieves a page of the topic's replies, starting at page 1.
+// The topic's opening post is not included.
+func (t *Topic) Posts(page int) (*CommentPage, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	return t.CommentThread().Comments((page-1)*TopicPostsPerPage, TopicPostsPerPage)
+}
+
+// Reply posts a reply to the topic.
+func (t *Topic) Reply(text string) error {
+	return t.CommentThread().Post(text)
+}
+
+// Lock prevents further replies to the topic.
+func (t *Topic) Lock() error {
+	err := t.moderate("lock", nil)
+
+	if err != nil {
+		return err
+	}
+
+	t.Locked = true
+
+	return nil
+}
+
+// Unlock allows replies to the topic again.
+func (t *Topic) Unlock() error {
+	err := t.moderate("unlock", nil)
+
+	if err != nil {
+		return err
+	}
+
+	t.Locked = false
+
+	return nil
+}
+
+// Stick pins the topic to the top of the forum.
+func (t *Topic) Stick() error {
+	err := t.moderate("stick", nil)
+
+	if err != nil {
+		return err
+	}
+
+	t.Sticky = true
+
+	return nil
+}
+
+// Unstick unpins the topic from the top of the forum.
+func (t *Topic) Unstick() error {
+	err := t.moderate("unstick", nil)
+
+	if err != nil {
+		return err
+	}
+
+	t.Sticky = false
+
+	return nil
+}
+
+// Delete deletes the topic.
+func (t *Topic) Delete() error {
+	return t.moderate("delete", nil)
+}
+
+// Move moves the topic to another forum of the same group.
+func (t *Topic) Move(forum *Forum) error {
+	err := t.moderate("move", map[string]string{"forumtarget": forum.ID})
+
+	if err != nil {
+		return err
+	}
+
+	t.forum = forum
+
+	return nil
+}
+
+// moderate sends an officer action for the topic.
+func (t *Topic) moderate(action string, extra map[string]string) error {
+	form := map[string]string{
+		"sessionid": t.forum.group.client.SessionID,
+		"action":    action,
+	}
+
+	for k, v := range extra {
+		form[k] = v
+	}
+
+	var moderateResp moderateTopicResponse
+	err := t.forum.group.client.postFormJSON(
+		fmt.Sprintf("https://steamcommunity.com/forum/%s/%s/moderatetopic/%s/", t.forum.group.ID, t.forum.ID, t.ID),
+		form,
+		&moderateResp,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if !moderateResp.Success {
+		return fmt.Errorf("steamcommunity: Failed to %s topic", action)
+	}
+
+	return nil
+}
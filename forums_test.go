@@ -0,0 +1,252 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// forumsResponse serves a group discussions page with a single forum.
+func forumsResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<div class="forum_list_item" data-gidforum="1291817208496421553">
+			<a class="forum_list_name" href="https://steamcommunity.com/groups/shival/discussions/0/">General Discussion</a>
+		</div>
+	`))
+}
+
+func (s *ClientTestSuite) TestForumTopics() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		forumsResponse,
+
+		// Topics request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="forum_topic sticky unread" id="forum_General_1291817208496421553_1291817208501234567" data-gidforumtopic="1291817208501234567">
+					<a class="forum_topic_overlay" href="https://steamcommunity.com/groups/shival/discussions/0/1291817208501234567/"></a>
+					<div class="forum_topic_reply_count">1,024</div>
+					<div class="forum_topic_name ">Server rules</div>
+					<div class="forum_topic_op" data-miniprofile="103542307">Alex</div>
+					<div class="forum_topic_lastpost" data-timestamp="1479119400">Nov 14 @ 10:30am</div>
+					<img class="forum_topic_locked" src="https://steamcommunity-a.akamaihd.net/public/images/skin_1/icon_locked.png">
+				</div>
+				<div class="forum_topic" id="forum_General_1291817208496421553_1291817208507654321" data-gidforumtopic="1291817208507654321">
+					<a class="forum_topic_overlay" href="https://steamcommunity.com/groups/shival/discussions/0/1291817208507654321/"></a>
+					<div class="forum_topic_reply_count">3</div>
+					<div class="forum_topic_name ">Map votes</div>
+					<div class="forum_topic_op" data-miniprofile="373562375">Sam</div>
+				</div>
+			`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	forums, err := group.Forums()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), forums, 1)
+	assert.Equal(s.T(), "1291817208496421553", forums[0].ID)
+	assert.Equal(s.T(), "General Discussion", forums[0].Name)
+
+	topics, err := forums[0].Topics(2)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "2", s.LastRequest.URL.Query().Get("fp"))
+	assert.Len(s.T(), topics, 2)
+	assert.Equal(s.T(), "1291817208501234567", topics[0].ID)
+	assert.Equal(s.T(), "Server rules", topics[0].Title)
+	assert.Equal(s.T(), "76561198063808035", topics[0].AuthorSteamID)
+	assert.Equal(s.T(), 1024, topics[0].Replies)
+	assert.Equal(s.T(), time.Unix(1479119400, 0), topics[0].LastPost)
+	assert.True(s.T(), topics[0].Sticky)
+	assert.True(s.T(), topics[0].Locked)
+	assert.Equal(s.T(), "Map votes", topics[1].Title)
+	assert.False(s.T(), topics[1].Sticky)
+	assert.False(s.T(), topics[1].Locked)
+}
+
+func (s *ClientTestSuite) TestTopicReply() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		forumsResponse,
+
+		// Create topic request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"gidforumtopic":"1291817208501234567"}`))
+		},
+
+		// Reply request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"start":0,"total_count":1,"comments_html":""}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	forums, err := group.Forums()
+	assert.NoError(s.T(), err)
+
+	topic, err := forums[0].CreateTopic("Map votes", "Which maps?")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/forum/103582791454641428/1291817208496421553/createtopic/", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "1291817208501234567", topic.ID)
+
+	err = topic.Reply("Badwater")
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/comment/ForumTopic/post/103582791454641428/1291817208496421553/", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "1291817208501234567", form.Get("feature2"))
+	assert.Equal(s.T(), "Badwater", form.Get("comment"))
+}
+
+func (s *ClientTestSuite) TestTopicModerate() {
+	var forms []url.Values
+	var paths []string
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Forums request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="forum_list_item" data-gidforum="1291817208496421553">
+					<a class="forum_list_name" href="https://steamcommunity.com/groups/shival/discussions/0/">General Discussion</a>
+				</div>
+				<div class="forum_list_item" data-gidforum="1291817208496421554">
+					<a class="forum_list_name" href="https://steamcommunity.com/groups/shival/discussions/1/">Archive</a>
+				</div>
+			`))
+		},
+
+		// Create topic request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"gidforumtopic":"1291817208501234567"}`))
+		},
+
+		// Moderate requests.
+		func(w http.ResponseWriter, r *http.Request) {
+			forms = append(forms, s.lastForm())
+			paths = append(paths, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	forums, err := group.Forums()
+	assert.NoError(s.T(), err)
+
+	topic, err := forums[0].CreateTopic("Map votes", "Which maps?")
+	assert.NoError(s.T(), err)
+
+	assert.NoError(s.T(), topic.Lock())
+	assert.True(s.T(), topic.Locked)
+	assert.NoError(s.T(), topic.Stick())
+	assert.True(s.T(), topic.Sticky)
+	assert.NoError(s.T(), topic.Unlock())
+	assert.False(s.T(), topic.Locked)
+	assert.NoError(s.T(), topic.Unstick())
+	assert.False(s.T(), topic.Sticky)
+
+	s.Require().Len(forums, 2)
+	assert.NoError(s.T(), topic.Move(forums[1]))
+	assert.NoError(s.T(), topic.Delete())
+
+	s.Require().Len(forms, 6)
+	for i, action := range []string{"lock", "stick", "unlock", "unstick", "move", "delete"} {
+		assert.Equal(s.T(), action, forms[i].Get("action"))
+		assert.Equal(s.T(), s.Client.SessionID, forms[i].Get("sessionid"))
+	}
+
+	assert.Equal(s.T(), "1291817208496421554", forms[4].Get("forumtarget"))
+	assert.Equal(s.T(), "/forum/103582791454641428/1291817208496421553/moderatetopic/1291817208501234567/", paths[4])
+
+	// After the move, actions are sent to the new forum.
+	assert.Equal(s.T(), "/forum/103582791454641428/1291817208496421554/moderatetopic/1291817208501234567/", paths[5])
+}
+
+func (s *ClientTestSuite) TestTopicModerateError() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		forumsResponse,
+
+		// Create topic request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"gidforumtopic":"1291817208501234567"}`))
+		},
+
+		// Lock request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":0}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	forums, err := group.Forums()
+	assert.NoError(s.T(), err)
+
+	topic, err := forums[0].CreateTopic("Map votes", "Which maps?")
+	assert.NoError(s.T(), err)
+
+	err = topic.Lock()
+
+	assert.Error(s.T(), err)
+	assert.False(s.T(), topic.Locked)
+}
+
+func (s *ClientTestSuite) TestTopicPosts() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		forumsResponse,
+
+		// Create topic request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"gidforumtopic":"1291817208501234567"}`))
+		},
+
+		// Posts request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"start":0,"total_count":0,"comments_html":""}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	forums, err := group.Forums()
+	assert.NoError(s.T(), err)
+
+	topic, err := forums[0].CreateTopic("Map votes", "Which maps?")
+	assert.NoError(s.T(), err)
+
+	_, err = topic.Posts(0)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "0", s.lastForm().Get("start"))
+}
@@ -0,0 +1,250 @@
+package steamcommunity
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+// FriendRequestRule is a requirement an incoming friend request must meet to be accepted.
+type FriendRequestRule struct {
+	// Name identifies the rule in decisions and logs.
+	Name string
+	// Allow reports whether the request meets the requirement.
+	// profile retrieves the sender's profile, and is only called by rules that need it.
+	// Private profiles are returned without an error, with only the fields Steam still shows.
+	Allow func(invite *Friend, profile func() (*Profile, error)) (bool, error)
+}
+
+// FriendRequestDecision is what a FriendRequestHandler did with a friend request.
+type FriendRequestDecision struct {
+	Time   time.Time
+	Invite *Friend
+	// Accepted is true if the request met every rule, and false if it was ignored.
+	Accepted bool
+	// Failed are the names of the rules the request did not meet.
+	Failed []string
+	// DryRun is true if the request was not accepted or ignored because the handler is in dry run mode.
+	DryRun bool
+	// Pending is true if the rules could not be evaluated or responding failed, in which case the request is checked again.
+	Pending bool
+	// Err is set if evaluating the rules, responding or welcoming the new friend failed.
+	Err error
+}
+
+// FriendRequestHandler accepts incoming friend requests that meet every rule, and ignores the rest.
+type FriendRequestHandler struct {
+	Client *Client
+	Rules  []FriendRequestRule
+
+	// DryRun logs decisions without accepting or ignoring requests.
+	DryRun bool
+
+	// Welcome is called after a request is accepted, such as to post a comment on the new friend's profile.
+	// profile is nil if no rule needed it.
+	Welcome func(friend *Friend, profile *Profile) error
+
+	// Logger receives a line for every decision, if it is not nil.
+	Logger *log.Logger
+
+	decided map[string]bool
+}
+
+// Check retrieves the pending friend requests and decides each one that has not been decided before.
+// Requests are forgotten once they are no longer pending, so a user who sends a new request later is decided again.
+func (h *FriendRequestHandler) Check() ([]FriendRequestDecision, error) {
+	invites, err := h.Client.PendingInvites()
+
+	if err != nil {
+		return nil, err
+	}
+
+	decided := make(map[string]bool, len(invites.Incoming))
+
+	var decisions []FriendRequestDecision
+	for _, invite := range invites.Incoming {
+		if h.decided[invite.SteamID] {
+			decided[invite.SteamID] = true
+			continue
+		}
+
+		decision := h.decide(invite)
+
+		if !decision.Pending {
+			decided[invite.SteamID] = true
+		}
+
+		h.log(decision)
+		decisions = append(decisions, decision)
+	}
+
+	h.decided = decided
+
+	return decisions, nil
+}
+
+// Run decides new friend requests every interval until ctx is done. Errors are passed to onError if it is not nil.
+func (h *FriendRequestHandler) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	return runEvery(ctx, interval, func() error {
+		_, err := h.Check()
+		return err
+	}, onError)
+}
+
+// decide evaluates every rule for a request, then accepts or ignores it.
+func (h *FriendRequestHandler) decide(invite *Friend) FriendRequestDecision {
+	decision := FriendRequestDecision{Time: time.Now(), Invite: invite, DryRun: h.DryRun}
+
+	var profile *Profile
+	lookup := func() (*Profile, error) {
+		if profile != nil {
+			return profile, nil
+		}
+
+		p, err := h.Client.Profile(invite.SteamID)
+
+		if err != nil && err != ErrorProfilePrivate {
+			return nil, err
+		}
+
+		profile = p
+
+		return profile, nil
+	}
+
+	for _, rule := range h.Rules {
+		allowed, err := rule.Allow(invite, lookup)
+
+		if err != nil {
+			decision.Err = err
+			decision.Pending = true
+			return decision
+		}
+
+		if !allowed {
+			decision.Failed = append(decision.Failed, rule.Name)
+		}
+	}
+
+	decision.Accepted = len(decision.Failed) == 0
+
+	if h.DryRun {
+		return decision
+	}
+
+	if !decision.Accepted {
+		decision.Err = h.Client.IgnoreFriendInvite(invite.SteamID)
+		decision.Pending = decision.Err != nil
+		return decision
+	}
+
+	if decision.Err = h.Client.AcceptFriendInvite(invite.SteamID); decision.Err != nil {
+		decision.Pending = true
+		return decision
+	}
+
+	if h.Welcome != nil {
+		decision.Err = h.Welcome(invite, profile)
+	}
+
+	return decision
+}
+
+func (h *FriendRequestHandler) log(decision FriendRequestDecision) {
+	if h.Logger == nil {
+		return
+	}
+
+	action := "ignored"
+	if decision.Accepted {
+		action = "accepted"
+	}
+
+	if decision.DryRun {
+		action = "would have " + action
+	}
+
+	switch {
+	case decision.Pending:
+		h.Logger.Printf("Friend request from %s (%s) left pending: %v", decision.Invite.Name, decision.Invite.SteamID, decision.Err)
+	case decision.Err != nil:
+		h.Logger.Printf("Friend request from %s (%s) %s: %v", decision.Invite.Name, decision.Invite.SteamID, action, decision.Err)
+	case len(decision.Failed) > 0:
+		h.Logger.Printf("Friend request from %s (%s) %s, failed %s", decision.Invite.Name, decision.Invite.SteamID, action, strings.Join(decision.Failed, ", "))
+	default:
+		h.Logger.Printf("Friend request from %s (%s) %s", decision.Invite.Name, decision.Invite.SteamID, action)
+	}
+}
+
+// InGroupRule allows requests from members of group, as shown on their profile.
+func InGroupRule(name string, group *Group) FriendRequestRule {
+	return FriendRequestRule{
+		Name: name,
+		Allow: func(invite *Friend, profile func() (*Profile, error)) (bool, error) {
+			p, err := profile()
+
+			if err != nil {
+				return false, err
+			}
+
+			for _, g := range p.Groups {
+				if g.ID == group.ID {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	}
+}
+
+// AccountAgeRule allows requests from accounts created at least minAge ago.
+// Accounts whose creation date is hidden are not allowed.
+func AccountAgeRule(name string, minAge time.Duration) FriendRequestRule {
+	return FriendRequestRule{
+		Name: name,
+		Allow: func(invite *Friend, profile func() (*Profile, error)) (bool, error) {
+			p, err := profile()
+
+			if err != nil {
+				return false, err
+			}
+
+			return !p.MemberSince.IsZero() && time.Since(p.MemberSince) >= minAge, nil
+		},
+	}
+}
+
+// NotVACBannedRule allows requests from accounts without a VAC ban.
+func NotVACBannedRule(name string) FriendRequestRule {
+	return FriendRequestRule{
+		Name: name,
+		Allow: func(invite *Friend, profile func() (*Profile, error)) (bool, error) {
+			p, err := profile()
+
+			if err != nil {
+				return false, err
+			}
+
+			return !p.VACBanned, nil
+		},
+	}
+}
+
+// MinLevelRule allows requests from accounts with a Steam level of at least minLevel.
+// Accounts whose level is hidden are not allowed.
+func MinLevelRule(name string, minLevel int) FriendRequestRule {
+	return FriendRequestRule{
+		Name: name,
+		Allow: func(invite *Friend, profile func() (*Profile, error)) (bool, error) {
+			p, err := profile()
+
+			if err != nil {
+				return false, err
+			}
+
+			return p.Level >= minLevel, nil
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package steamcommunity_test
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pendingInvitesResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<div id="pending_received_invites">
+			<div class="selectable friend_block_v2 persona online " data-steamid="76561198063808035">
+				<div class="friend_block_content">shival<br></div>
+			</div>
+			<div class="selectable friend_block_v2 persona offline " data-steamid="76561198063808036">
+				<div class="friend_block_content">stranger<br></div>
+			</div>
+		</div>
+	`))
+}
+
+func noPendingInvitesResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`<div id="pending_received_invites"></div>`))
+}
+
+func privateProfileResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`<profile>
+		<steamID64>76561198063808036</steamID64>
+		<steamID><![CDATA[stranger]]></steamID>
+		<privacyState>private</privacyState>
+	</profile>`))
+}
+
+func friendActionSuccessResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"success":1}`))
+}
+
+func (s *ClientTestSuite) TestFriendRequestHandler() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		pendingInvitesResponse,
+
+		// First request is accepted.
+		profileResponse,
+		profilePageResponse,
+		friendActionSuccessResponse,
+
+		// Second request is ignored.
+		privateProfileResponse,
+		friendActionSuccessResponse,
+
+		// Both requests have been responded to.
+		noPendingInvitesResponse,
+
+		// The ignored user sends a new request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div id="pending_received_invites">
+					<div class="selectable friend_block_v2 persona offline " data-steamid="76561198063808036">
+						<div class="friend_block_content">stranger<br></div>
+					</div>
+				</div>
+			`))
+		},
+		privateProfileResponse,
+		friendActionSuccessResponse,
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	var logs bytes.Buffer
+	var welcomed []string
+
+	handler := &steamcommunity.FriendRequestHandler{
+		Client: s.Client,
+		Rules: []steamcommunity.FriendRequestRule{
+			steamcommunity.InGroupRule("in group", group),
+			steamcommunity.MinLevelRule("level", 10),
+			steamcommunity.NotVACBannedRule("vac"),
+		},
+		Welcome: func(friend *steamcommunity.Friend, profile *steamcommunity.Profile) error {
+			welcomed = append(welcomed, profile.PersonaName)
+			return nil
+		},
+		Logger: log.New(&logs, "", 0),
+	}
+
+	decisions, err := handler.Check()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), decisions, 2)
+
+	assert.True(s.T(), decisions[0].Accepted)
+	assert.Empty(s.T(), decisions[0].Failed)
+
+	assert.False(s.T(), decisions[1].Accepted)
+	assert.Equal(s.T(), []string{"in group", "level"}, decisions[1].Failed)
+	assert.NoError(s.T(), decisions[1].Err)
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "ignore", form.Get("action"))
+	assert.Equal(s.T(), []string{"shival"}, welcomed)
+	assert.Equal(s.T(), "Friend request from shival (76561198063808035) accepted\n"+
+		"Friend request from stranger (76561198063808036) ignored, failed in group, level\n", logs.String())
+
+	decisions, err = handler.Check()
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), decisions)
+
+	// A new request from a user who was ignored before is decided again.
+	decisions, err = handler.Check()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), decisions, 1)
+	assert.Equal(s.T(), "76561198063808036", decisions[0].Invite.SteamID)
+	assert.False(s.T(), decisions[0].Accepted)
+	assert.Equal(s.T(), "ignore", s.lastForm().Get("action"))
+}
+
+func (s *ClientTestSuite) TestFriendRequestHandlerDryRun() {
+	s.login(
+		pendingInvitesResponse,
+		profileResponse,
+		profilePageResponse,
+		privateProfileResponse,
+		pendingInvitesResponse,
+	)
+
+	handler := &steamcommunity.FriendRequestHandler{
+		Client: s.Client,
+		Rules:  []steamcommunity.FriendRequestRule{steamcommunity.MinLevelRule("level", 10)},
+		DryRun: true,
+	}
+
+	decisions, err := handler.Check()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), decisions, 2)
+	assert.True(s.T(), decisions[0].Accepted)
+	assert.True(s.T(), decisions[0].DryRun)
+	assert.False(s.T(), decisions[1].Accepted)
+	assert.Equal(s.T(), "/profiles/76561198063808036/", s.LastRequest.URL.Path)
+
+	// Requests left pending by a dry run are only decided once.
+	decisions, err = handler.Check()
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), decisions)
+}
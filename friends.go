@@ -0,0 +1,228 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	friendBlockRegexp   = regexp.MustCompile(`<div class="[^"]*friend_block_v2 persona ([\w-]+)[^"]*"[^>]*data-steamid="(\d+)"`)
+	friendNameRegexp    = regexp.MustCompile(`(?s)<div class="friend_block_content">(.*?)<br`)
+	friendSectionRegexp = regexp.MustCompile(`id="(pending_received_invites|pending_sent_invites)"`)
+)
+
+// Friend is a user on the logged in user's friends list, or with a pending friend invite.
+type Friend struct {
+	SteamID     string
+	Name        string
+	OnlineState ProfileOnlineState
+	// FriendSince is when the friendship began. It is only set by Friends, when Client.APIKey is set.
+	FriendSince time.Time
+}
+
+// FriendInvites are the logged in user's pending friend invites.
+type FriendInvites struct {
+	// Incoming are users who have invited the logged in user.
+	Incoming []*Friend
+	// Outgoing are users the logged in user has invited.
+	Outgoing []*Friend
+}
+
+type friendListResponse struct {
+	FriendsList struct {
+		Friends []struct {
+			SteamID     string `json:"steamid"`
+			FriendSince int64  `json:"friend_since"`
+		} `json:"friends"`
+	} `json:"friendslist"`
+}
+
+type addFriendResponse struct {
+	Success steamBool `json:"success"`
+	// FailedInvitesResult contains an EResult for each invite that failed.
+	FailedInvitesResult []int `json:"failed_invites_result"`
+}
+
+// Friends retrieves the logged in user's friends list.
+// If Client.APIKey is set, FriendSince is retrieved from ISteamUser/GetFriendList.
+func (c *Client) Friends() ([]*Friend, error) {
+	body, err := c.getPage(fmt.Sprintf("https://steamcommunity.com/profiles/%s/friends/", c.SteamID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	friends := parseFriendBlocks(body)
+
+	if c.APIKey == "" {
+		return friends, nil
+	}
+
+	since, err := c.friendsSince()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, friend := range friends {
+		friend.FriendSince = since[friend.SteamID]
+	}
+
+	return friends, nil
+}
+
+// PendingInvites retrieves the logged in user's incoming and outgoing friend invites.
+func (c *Client) PendingInvites() (*FriendInvites, error) {
+	body, err := c.getPage(fmt.Sprintf("https://steamcommunity.com/profiles/%s/friends/pending", c.SteamID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	invites := &FriendInvites{}
+
+	indices := friendSectionRegexp.FindAllStringSubmatchIndex(body, -1)
+	for i, index := range indices {
+		end := len(body)
+		if i+1 < len(indices) {
+			end = indices[i+1][0]
+		}
+
+		friends := parseFriendBlocks(body[index[1]:end])
+
+		if body[index[2]:index[3]] == "pending_received_invites" {
+			invites.Incoming = append(invites.Incoming, friends...)
+		} else {
+			invites.Outgoing = append(invites.Outgoing, friends...)
+		}
+	}
+
+	return invites, nil
+}
+
+// AddFriend sends a friend invite to the user with the given SteamID64.
+func (c *Client) AddFriend(steamID string) error {
+	var addResp addFriendResponse
+	err := c.postFormJSON("https://steamcommunity.com/actions/AddFriendAjax", map[string]string{
+		"sessionID":     c.SessionID,
+		"steamid":       steamID,
+		"accept_invite": "0",
+	}, &addResp)
+
+	if err != nil {
+		return err
+	}
+
+	if !addResp.Success {
+		if len(addResp.FailedInvitesResult) > 0 {
+			return fmt.Errorf("steamcommunity: Friend invite failed (EResult %d)", addResp.FailedInvitesResult[0])
+		}
+
+		return errors.New("steamcommunity: Friend invite failed")
+	}
+
+	return nil
+}
+
+// RemoveFriend removes the user with the given SteamID64 from the logged in user's friends list.
+func (c *Client) RemoveFriend(steamID string) error {
+	return c.friendsAjax("RemoveFriendAjax", map[string]string{"steamid": steamID})
+}
+
+// Block blocks all communication with the user with the given SteamID64.
+func (c *Client) Block(steamID string) error {
+	return c.friendsAjax("BlockUserAjax", map[string]string{"steamid": steamID, "block": "1"})
+}
+
+// Unblock unblocks the user with the given SteamID64.
+func (c *Client) Unblock(steamID string) error {
+	return c.friendsAjax("BlockUserAjax", map[string]string{"steamid": steamID, "block": "0"})
+}
+
+// AcceptFriendInvite accepts an incoming friend invite from the user with the given SteamID64.
+func (c *Client) AcceptFriendInvite(steamID string) error {
+	return c.friendAction("accept", []string{steamID})
+}
+
+// IgnoreFriendInvite ignores an incoming friend invite from the user with the given SteamID64.
+func (c *Client) IgnoreFriendInvite(steamID string) error {
+	return c.friendAction("ignore", []string{steamID})
+}
+
+// friendsAjax posts to one of the /actions/ friend endpoints.
+func (c *Client) friendsAjax(action string, form map[string]string) error {
+	form["sessionID"] = c.SessionID
+
+	resp, err := c.postForm(fmt.Sprintf("https://steamcommunity.com/actions/%s", action), map[string]string{}, form)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// friendsSince retrieves when each friendship began from the Web API, keyed by SteamID64.
+func (c *Client) friendsSince() (map[string]time.Time, error) {
+	resp, err := c.get(fmt.Sprintf(
+		"https://api.steampowered.com/ISteamUser/GetFriendList/v1/?key=%s&steamid=%s&relationship=friend",
+		c.APIKey,
+		c.SteamID,
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var listResp friendListResponse
+	if err = json.Unmarshal(body, &listResp); err != nil {
+		return nil, err
+	}
+
+	since := make(map[string]time.Time, len(listResp.FriendsList.Friends))
+	for _, friend := range listResp.FriendsList.Friends {
+		since[friend.SteamID] = time.Unix(friend.FriendSince, 0)
+	}
+
+	return since, nil
+}
+
+// parseFriendBlocks parses the friend blocks on a friends list page.
+func parseFriendBlocks(page string) []*Friend {
+	var friends []*Friend
+
+	indices := friendBlockRegexp.FindAllStringSubmatchIndex(page, -1)
+	for i, index := range indices {
+		end := len(page)
+		if i+1 < len(indices) {
+			end = indices[i+1][0]
+		}
+
+		friend := &Friend{
+			SteamID:     page[index[4]:index[5]],
+			OnlineState: ProfileOnlineState(page[index[2]:index[3]]),
+		}
+
+		if match := friendNameRegexp.FindStringSubmatch(page[index[0]:end]); match != nil {
+			friend.Name = strings.TrimSpace(htmlToText(match[1]))
+		}
+
+		friends = append(friends, friend)
+	}
+
+	return friends
+}
@@ -0,0 +1,125 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func friendsResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<div id="search_results">
+			<div class="selectable friend_block_v2 persona in-game " data-steamid="76561198063808036" data-miniprofile="103542308">
+				<div class="friend_block_content">alice &amp; co<br><span class="friend_small_text">Team Fortress 2</span></div>
+			</div>
+			<div class="selectable friend_block_v2 persona offline " data-steamid="76561198063808037" data-miniprofile="103542309">
+				<div class="friend_block_content">bob<br><span class="friend_last_online_text">Last Online 3 days ago</span></div>
+			</div>
+		</div>
+	`))
+}
+
+func (s *ClientTestSuite) TestFriends() {
+	s.login(friendsResponse)
+
+	friends, err := s.Client.Friends()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*steamcommunity.Friend{
+		{SteamID: "76561198063808036", Name: "alice & co", OnlineState: steamcommunity.ProfileInGame},
+		{SteamID: "76561198063808037", Name: "bob", OnlineState: steamcommunity.ProfileOffline},
+	}, friends)
+}
+
+func (s *ClientTestSuite) TestFriendsSince() {
+	s.login(
+		friendsResponse,
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "/ISteamUser/GetFriendList/v1/", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"friendslist":{"friends":[{"steamid":"76561198063808036","relationship":"friend","friend_since":1500000000}]}}`))
+		},
+	)
+
+	s.Client.APIKey = "key"
+
+	friends, err := s.Client.Friends()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), time.Unix(1500000000, 0), friends[0].FriendSince)
+	assert.True(s.T(), friends[1].FriendSince.IsZero())
+}
+
+func (s *ClientTestSuite) TestPendingInvites() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<div id="pending_received_invites">
+				<div class="selectable friend_block_v2 persona online " data-steamid="76561198063808036">
+					<div class="friend_block_content">alice<br></div>
+				</div>
+			</div>
+			<div id="pending_sent_invites">
+				<div class="selectable friend_block_v2 persona offline " data-steamid="76561198063808037">
+					<div class="friend_block_content">bob<br></div>
+				</div>
+			</div>
+		`))
+	})
+
+	invites, err := s.Client.PendingInvites()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), invites.Incoming, 1)
+	assert.Equal(s.T(), "76561198063808036", invites.Incoming[0].SteamID)
+	assert.Len(s.T(), invites.Outgoing, 1)
+	assert.Equal(s.T(), "bob", invites.Outgoing[0].Name)
+}
+
+func (s *ClientTestSuite) TestAddFriend() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"invited":["76561198063808036"],"success":1}`))
+	})
+
+	assert.NoError(s.T(), s.Client.AddFriend("76561198063808036"))
+	assert.Equal(s.T(), "/actions/AddFriendAjax", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "76561198063808036", s.lastForm().Get("steamid"))
+}
+
+func (s *ClientTestSuite) TestAddFriendFailed() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"failed_invites":["76561198063808036"],"failed_invites_result":[25],"success":0}`))
+	})
+
+	err := s.Client.AddFriend("76561198063808036")
+	assert.EqualError(s.T(), err, "steamcommunity: Friend invite failed (EResult 25)")
+}
+
+func (s *ClientTestSuite) TestBlock() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	assert.NoError(s.T(), s.Client.Block("76561198063808036"))
+	assert.Equal(s.T(), "1", s.lastForm().Get("block"))
+
+	assert.NoError(s.T(), s.Client.Unblock("76561198063808036"))
+	assert.Equal(s.T(), "0", s.lastForm().Get("block"))
+	assert.Equal(s.T(), "/actions/BlockUserAjax", s.LastRequest.URL.Path)
+}
+
+func (s *ClientTestSuite) TestAcceptFriendInvite() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":1}`))
+	})
+
+	assert.NoError(s.T(), s.Client.AcceptFriendInvite("76561198063808036"))
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "accept", form.Get("action"))
+	assert.Equal(s.T(), []string{"76561198063808036"}, form["steamids[]"])
+}
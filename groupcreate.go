@@ -0,0 +1,85 @@
+package steamcommunity
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+var (
+	ErrorGroupURLTaken            = errors.New("steamcommunity: Group URL is already taken")
+	ErrorGroupURLInvalid          = errors.New("steamcommunity: Group URL is invalid")
+	ErrorGroupNameInvalid         = errors.New("steamcommunity: Group name is invalid")
+	ErrorGroupAbbreviationInvalid = errors.New("steamcommunity: Group abbreviation is invalid")
+)
+
+// groupCreateFieldErrors maps the fields of the group creation form to the error returned when Steam rejects them.
+var groupCreateFieldErrors = map[string]error{
+	"groupLink":    ErrorGroupURLInvalid,
+	"groupName":    ErrorGroupNameInvalid,
+	"abbreviation": ErrorGroupAbbreviationInvalid,
+}
+
+type availabilityCheckResponse struct {
+	Available steamBool `json:"bAvailable"`
+}
+
+// CreateGroup creates a new Steam Group owned by the logged in user, available at https://steamcommunity.com/groups/<vanityURL>.
+// If Steam rejects the group, the error is one of ErrorGroupURLTaken, ErrorGroupURLInvalid, ErrorGroupNameInvalid or ErrorGroupAbbreviationInvalid.
+func (c *Client) CreateGroup(name string, abbreviation string, vanityURL string, public bool) (*Group, error) {
+	available, err := c.groupURLAvailable(vanityURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !available {
+		return nil, ErrorGroupURLTaken
+	}
+
+	resp, err := c.postForm("https://steamcommunity.com/actions/GroupCreate", map[string]string{}, map[string]string{
+		"sessionID":    c.SessionID,
+		"groupName":    name,
+		"abbreviation": abbreviation,
+		"groupLink":    vanityURL,
+		"bIsPublic":    boolToForm(public),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	if match := groupProfileErrorRegexp.FindSubmatch(body); match != nil {
+		if err, ok := groupCreateFieldErrors[string(match[1])]; ok {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("steamcommunity: %s", htmlToText(string(match[2])))
+	}
+
+	return c.Group(vanityURL)
+}
+
+// groupURLAvailable checks whether a group vanity URL is free to use.
+func (c *Client) groupURLAvailable(vanityURL string) (bool, error) {
+	var availabilityResp availabilityCheckResponse
+	err := c.postFormJSON("https://steamcommunity.com/actions/AvailabilityCheck", map[string]string{
+		"sessionid": c.SessionID,
+		"type":      "groupLink",
+		"value":     vanityURL,
+	}, &availabilityResp)
+
+	if err != nil {
+		return false, err
+	}
+
+	return bool(availabilityResp.Available), nil
+}
@@ -0,0 +1,85 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func availabilityResponse(available bool) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if available {
+			w.Write([]byte(`{"bAvailable":true}`))
+		} else {
+			w.Write([]byte(`{"bAvailable":false}`))
+		}
+	}
+}
+
+func (s *ClientTestSuite) TestCreateGroup() {
+	var form url.Values
+	var paths []string
+	s.login(
+		availabilityResponse(true),
+		// Create response.
+		func(w http.ResponseWriter, r *http.Request) {
+			form = s.lastForm()
+			paths = append(paths, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		},
+		// Group response.
+		func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			groupResponse(w, r)
+		},
+	)
+
+	group, err := s.Client.CreateGroup("Shival", "SHV", "shival", true)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "103582791454641428", group.ID)
+
+	assert.Equal(s.T(), s.Client.SessionID, form.Get("sessionID"))
+	assert.Equal(s.T(), "Shival", form.Get("groupName"))
+	assert.Equal(s.T(), "SHV", form.Get("abbreviation"))
+	assert.Equal(s.T(), "shival", form.Get("groupLink"))
+	assert.Equal(s.T(), "1", form.Get("bIsPublic"))
+
+	s.Require().Len(paths, 3)
+	assert.Equal(s.T(), "/actions/GroupCreate", paths[0])
+	for _, path := range paths[1:] {
+		assert.True(s.T(), strings.HasPrefix(path, "/groups/shival"), path)
+	}
+}
+
+func (s *ClientTestSuite) TestCreateGroupURLTaken() {
+	s.login(availabilityResponse(false))
+
+	group, err := s.Client.CreateGroup("Shival", "SHV", "shival", true)
+	assert.Equal(s.T(), steamcommunity.ErrorGroupURLTaken, err)
+	assert.Nil(s.T(), group)
+	assert.Equal(s.T(), "shival", s.lastForm().Get("value"))
+}
+
+func (s *ClientTestSuite) TestCreateGroupNameInvalid() {
+	s.login(
+		availabilityResponse(true),
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`<div class="formRowError" id="error_groupName">Please enter a valid group name.</div>`))
+		},
+	)
+
+	group, err := s.Client.CreateGroup("", "SHV", "shival", false)
+	assert.Equal(s.T(), steamcommunity.ErrorGroupNameInvalid, err)
+	assert.Nil(s.T(), group)
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "shival", form.Get("groupLink"))
+	assert.Equal(s.T(), "SHV", form.Get("abbreviation"))
+	assert.Equal(s.T(), "0", form.Get("bIsPublic"))
+}
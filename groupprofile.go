@@ -0,0 +1,185 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MaxGroupLinks is the number of official links a Steam Group can show.
+const MaxGroupLinks = 3
+
+var groupProfileErrorRegexp = regexp.MustCompile(`(?s)<div class="formRowError" id="error_([^"]*)">(.*?)</div>`)
+
+// GroupProfileUpdate is a change to a group's profile.
+// Nil fields are left unchanged, and fields set to an empty string or slice are cleared.
+type GroupProfileUpdate struct {
+	Headline *string
+	// Summary is BBCode.
+	Summary         *string
+	AssociatedGames []int
+	Links           []GroupLink
+	// Country is a two letter country code, such as "AU".
+	Country *string
+}
+
+// GroupProfileError is a problem Steam found with a field of a GroupProfileUpdate.
+type GroupProfileError struct {
+	Field   string
+	Message string
+}
+
+// GroupProfileErrors are returned by UpdateProfile when Steam rejects the update.
+type GroupProfileErrors []GroupProfileError
+
+type avatarUploadResponse struct {
+	Success steamBool         `json:"success"`
+	Message string            `json:"message"`
+	Images  map[string]string `json:"images"`
+}
+
+func (e GroupProfileErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = fmt.Sprintf("%s: %s", err.Field, err.Message)
+	}
+
+	return fmt.Sprintf("steamcommunity: Invalid group profile (%s)", strings.Join(messages, ", "))
+}
+
+// UpdateProfile updates the group's profile, then refreshes the group.
+// If Steam rejects the update, the error is GroupProfileErrors.
+func (g *Group) UpdateProfile(update GroupProfileUpdate) error {
+	uri := fmt.Sprintf("https://steamcommunity.com/gid/%s/edit", g.ID)
+
+	// Start from the current values, as Steam clears fields that are not sent.
+	page, err := g.client.getPage(uri)
+
+	if err != nil {
+		return err
+	}
+
+	values, ok := parseFormValues(page, "editForm")
+
+	if !ok {
+		return errors.New("steamcommunity: Group edit form missing from page")
+	}
+
+	values.Set("sessionID", g.client.SessionID)
+	values.Set("type", "profileSave")
+
+	for field, value := range map[string]*string{
+		"headline": update.Headline,
+		"summary":  update.Summary,
+		"country":  update.Country,
+	} {
+		if value != nil {
+			values.Set(field, *value)
+		}
+	}
+
+	if update.AssociatedGames != nil {
+		values.Del("associated_apps[]")
+		for _, appID := range update.AssociatedGames {
+			values.Add("associated_apps[]", strconv.Itoa(appID))
+		}
+	}
+
+	if update.Links != nil {
+		if len(update.Links) > MaxGroupLinks {
+			return fmt.Errorf("steamcommunity: A group can have at most %d links", MaxGroupLinks)
+		}
+
+		for i := 1; i <= MaxGroupLinks; i++ {
+			title, url := "", ""
+			if i <= len(update.Links) {
+				title, url = update.Links[i-1].Title, update.Links[i-1].URL
+			}
+
+			values.Set(fmt.Sprintf("weblink_%d_title", i), title)
+			values.Set(fmt.Sprintf("weblink_%d_url", i), url)
+		}
+	}
+
+	resp, err := g.client.postValues(uri, map[string]string{}, values)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	var errs GroupProfileErrors
+	for _, match := range groupProfileErrorRegexp.FindAllSubmatch(body, -1) {
+		errs = append(errs, GroupProfileError{Field: string(match[1]), Message: htmlToText(string(match[2]))})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return g.Refresh()
+}
+
+// UploadAvatar replaces the group's avatar with the image read from r, then refreshes the group.
+func (g *Group) UploadAvatar(r io.Reader) error {
+	_, err := g.client.uploadAvatar(map[string]string{
+		"type": "group_avatar_image",
+		"gId":  g.ID,
+	}, r)
+
+	if err != nil {
+		return err
+	}
+
+	return g.Refresh()
+}
+
+// uploadAvatar uploads an avatar image for the user or group identified by fields.
+func (c *Client) uploadAvatar(fields map[string]string, r io.Reader) (*avatarUploadResponse, error) {
+	fields["MAX_FILE_SIZE"] = "1048576"
+	fields["sessionid"] = c.SessionID
+	fields["doSub"] = "1"
+	fields["json"] = "1"
+
+	resp, err := c.postMultipart("https://steamcommunity.com/actions/FileUploader", fields, "avatar", "avatar.jpg", r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var uploadResp avatarUploadResponse
+	err = json.Unmarshal(body, &uploadResp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !uploadResp.Success {
+		if uploadResp.Message != "" {
+			return nil, fmt.Errorf("steamcommunity: %s", uploadResp.Message)
+		}
+
+		return nil, errors.New("steamcommunity: Avatar upload failed")
+	}
+
+	return &uploadResp, nil
+}
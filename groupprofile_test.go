@@ -0,0 +1,154 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// groupEditResponse serves a group edit page with the group's current values.
+func groupEditResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<form action="/search" method="get">
+			<input type="text" name="text" value="q">
+		</form>
+		<form id="editForm" method="post">
+			<input type="hidden" name="sessionID" value="">
+			<input type="text" data-name="ignored" name="headline" data-value="ignored" value="Old &amp; busted">
+			<textarea name="summary">Welcome!</textarea>
+			<select name="country">
+				<option value="">Select a country</option>
+				<option value="AU" selected>Australia</option>
+			</select>
+			<input type="hidden" name="associated_apps[]" value="440">
+			<input type="text" name="weblink_1_title" value="Website">
+			<input type="text" name="weblink_1_url" value="https://example.com/">
+			<input type="checkbox" name="isPublic" value="1">
+			<input type="submit" value="Save">
+		</form>
+	`))
+}
+
+func (s *ClientTestSuite) TestUpdateGroupProfile() {
+	var form url.Values
+	s.login(
+		groupResponse,
+		groupResponse,
+		groupEditResponse,
+
+		// Save request.
+		func(w http.ResponseWriter, r *http.Request) {
+			form = s.lastForm()
+			w.WriteHeader(http.StatusOK)
+		},
+
+		groupResponse,
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	summary, country := "[b]Welcome![/b]", ""
+	err = group.UpdateProfile(steamcommunity.GroupProfileUpdate{
+		Summary:         &summary,
+		AssociatedGames: []int{440, 730},
+		Country:         &country,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "profileSave", form.Get("type"))
+	assert.Equal(s.T(), "[b]Welcome![/b]", form.Get("summary"))
+	assert.Equal(s.T(), []string{"440", "730"}, form["associated_apps[]"])
+	assert.Equal(s.T(), "Old & busted", form.Get("headline"))
+	assert.Contains(s.T(), form, "country")
+	assert.Empty(s.T(), form.Get("country"))
+	assert.Equal(s.T(), "Website", form.Get("weblink_1_title"))
+	assert.Equal(s.T(), "https://example.com/", form.Get("weblink_1_url"))
+	assert.NotContains(s.T(), form, "isPublic")
+	assert.NotContains(s.T(), form, "text")
+	assert.NotContains(s.T(), form, "ignored")
+}
+
+func (s *ClientTestSuite) TestUploadGroupAvatar() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Upload request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"images":{"full":"https://example.com/avatar_full.jpg"}}`))
+		},
+
+		groupResponse,
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	err = group.UploadAvatar(strings.NewReader("not really a jpeg"))
+	assert.NoError(s.T(), err)
+}
+
+func (s *ClientTestSuite) TestUploadGroupAvatarFailed() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Upload request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":false,"message":"The image is too large."}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	err = group.UploadAvatar(strings.NewReader("not really a jpeg"))
+	assert.EqualError(s.T(), err, "steamcommunity: The image is too large.")
+	assert.Contains(s.T(), s.LastRequest.Header.Get("Content-Type"), "multipart/form-data")
+}
+
+func (s *ClientTestSuite) TestUpdateGroupProfileErrors() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		groupEditResponse,
+
+		// Save request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="formRowError" id="error_summary">Your summary is too long.</div>
+			`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	summary := "[b]Welcome![/b]"
+	err = group.UpdateProfile(steamcommunity.GroupProfileUpdate{
+		Summary:         &summary,
+		AssociatedGames: []int{440, 730},
+	})
+
+	form := s.lastForm()
+	assert.Equal(s.T(), steamcommunity.GroupProfileErrors{
+		{Field: "summary", Message: "Your summary is too long."},
+	}, err)
+	assert.Equal(s.T(), "profileSave", form.Get("type"))
+	assert.Equal(s.T(), "Old & busted", form.Get("headline"))
+	assert.Equal(s.T(), "[b]Welcome![/b]", form.Get("summary"))
+	assert.Equal(s.T(), "AU", form.Get("country"))
+	assert.Equal(s.T(), []string{"440", "730"}, form["associated_apps[]"])
+	assert.Equal(s.T(), "https://example.com/", form.Get("weblink_1_url"))
+	assert.Empty(s.T(), form.Get("isPublic"))
+	assert.NotEmpty(s.T(), form.Get("sessionID"))
+}
@@ -1,16 +1,26 @@
 package steamcommunity
 
 import (
+	"context"
 	"encoding/xml"
-	"errors"
 	"fmt"
 	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	groupAbbreviationRegexp = regexp.MustCompile(`class="grouppage_header_abbrev"[^>]*>([^<]*)<`)
+	groupStatRegexp         = regexp.MustCompile(`(?s)<div class="label">([^<]+)</div>\s*<div class="data">(.*?)</div>`)
+	groupGameRegexp         = regexp.MustCompile(`(?s)class="group_associated_game_name">\s*<a [^>]*href="https?://steamcommunity\.com/app/(\d+)/?"[^>]*>(.*?)</a>`)
+	groupLinkRegexp         = regexp.MustCompile(`(?s)<div class="weblink">.*?<a [^>]*href="([^"]+)"[^>]*>(.*?)</a>`)
 )
 
 type groupMemberList struct {
 	GroupID64         string       `xml:"groupID64"`
 	GroupDetails      groupDetails `xml:"groupDetails"`
-	MemberCount       int          `xml:"memberCount"`
 	MemberTotalPages  int          `xml:"totalPages"`
 	MemberCurrentPage int          `xml:"currentPage"`
 	Members           groupMembers `xml:"members"`
@@ -35,88 +45,167 @@ type groupMembers struct {
 }
 
 type Group struct {
-	ID            string
-	Name          string
-	URL           string
-	Headline      string
-	Summary       string
-	AvatarIcon    string
-	AvatarMedium  string
-	AvatarFull    string
-	Members       []string
-	MembersInChat int
-	MembersInGame int
-	MembersOnline int
+	ID              string
+	Name            string
+	URL             string
+	Abbreviation    string
+	Headline        string
+	Summary         string
+	AvatarIcon      string
+	AvatarMedium    string
+	AvatarFull      string
+	Founded         time.Time
+	Country         string
+	AssociatedGames []GroupGame
+	Links           []GroupLink
+	Members         []string
+	MemberCount     int
+	MembersInChat   int
+	MembersInGame   int
+	MembersOnline   int
 
 	client *Client
 }
 
+// GroupGame is a game associated with a Steam Group.
+type GroupGame struct {
+	AppID int
+	Name  string
+}
+
+// GroupLink is an official link shown on a Steam Group's page.
+type GroupLink struct {
+	Title string
+	URL   string
+}
+
 // Group retrieves a Steam Group by group URL.
+// Members only contains the first page of the member list, use AllMembers or MembersIter to retrieve every member.
 func (c *Client) Group(groupID string) (*Group, error) {
-	resp, err := c.get(
-		fmt.Sprintf("https://steamcommunity.com/groups/%s/memberslistxml/?xml=1", groupID),
-	)
+	group := &Group{client: c}
+	err := group.load(fmt.Sprintf("groups/%s", groupID))
 
 	if err != nil {
 		return nil, err
 	}
 
-	body, _ := ioutil.ReadAll(resp.Body)
-	var xmlResp groupMemberList
-	err = xml.Unmarshal(body, &xmlResp)
+	return group, nil
+}
+
+// GroupByID retrieves a Steam Group by its SteamID64.
+func (c *Client) GroupByID(steamID string) (*Group, error) {
+	group := &Group{client: c}
+	err := group.load(fmt.Sprintf("gid/%s", steamID))
 
 	if err != nil {
 		return nil, err
 	}
 
-	group := &Group{}
+	return group, nil
+}
+
+// Refresh retrieves the group again, updating its fields in place.
+func (g *Group) Refresh() error {
+	// Start from the current group so the page fields are kept if the page can't be retrieved.
+	group := *g
+	err := group.load(fmt.Sprintf("gid/%s", g.ID))
+
+	if err != nil {
+		return err
+	}
+
+	*g = group
+
+	return nil
+}
+
+// load populates the group from its member list XML and group page.
+// The group page only adds details, so if it can't be retrieved the fields it populates are left unchanged.
+func (g *Group) load(path string) error {
+	xmlResp, err := g.client.groupMemberList(context.Background(), path, 1)
+
+	if err != nil {
+		return err
+	}
 
 	// Populate group.
-	group.ID = xmlResp.GroupID64
-	group.Name = xmlResp.GroupDetails.GroupName
-	group.URL = xmlResp.GroupDetails.GroupURL
-	group.Headline = xmlResp.GroupDetails.Headline
-	group.Summary = xmlResp.GroupDetails.Summary
-	group.AvatarIcon = xmlResp.GroupDetails.AvatarIcon
-	group.AvatarMedium = xmlResp.GroupDetails.AvatarMedium
-	group.AvatarFull = xmlResp.GroupDetails.AvatarFull
-	group.Members = xmlResp.Members.SteamID64
-	group.MembersInChat = xmlResp.GroupDetails.MembersInChat
-	group.MembersInGame = xmlResp.GroupDetails.MembersInGame
-	group.MembersOnline = xmlResp.GroupDetails.MembersOnline
-	group.client = c
+	g.ID = xmlResp.GroupID64
+	g.Name = xmlResp.GroupDetails.GroupName
+	g.URL = xmlResp.GroupDetails.GroupURL
+	g.Headline = xmlResp.GroupDetails.Headline
+	g.Summary = xmlResp.GroupDetails.Summary
+	g.AvatarIcon = xmlResp.GroupDetails.AvatarIcon
+	g.AvatarMedium = xmlResp.GroupDetails.AvatarMedium
+	g.AvatarFull = xmlResp.GroupDetails.AvatarFull
+	g.Members = xmlResp.Members.SteamID64
+	g.MemberCount = xmlResp.GroupDetails.MemberCount
+	g.MembersInChat = xmlResp.GroupDetails.MembersInChat
+	g.MembersInGame = xmlResp.GroupDetails.MembersInGame
+	g.MembersOnline = xmlResp.GroupDetails.MembersOnline
+
+	if page, err := g.client.getPage(fmt.Sprintf("https://steamcommunity.com/%s", path)); err == nil {
+		g.parsePage(page)
+	}
 
-	return group, nil
+	return nil
+}
+
+// parsePage populates the fields that are only available on the group page.
+func (g *Group) parsePage(page string) {
+	g.Abbreviation = ""
+	g.Founded = time.Time{}
+	g.Country = ""
+
+	if match := groupAbbreviationRegexp.FindStringSubmatch(page); match != nil {
+		g.Abbreviation = htmlToText(match[1])
+	}
+
+	for _, match := range groupStatRegexp.FindAllStringSubmatch(page, -1) {
+		switch strings.TrimSpace(match[1]) {
+		case "Founded":
+			g.Founded, _ = parseSteamDate(htmlToText(match[2]), time.UTC)
+		case "Location":
+			g.Country = htmlToText(match[2])
+		}
+	}
+
+	g.AssociatedGames = nil
+	for _, match := range groupGameRegexp.FindAllStringSubmatch(page, -1) {
+		appID, _ := strconv.Atoi(match[1])
+		g.AssociatedGames = append(g.AssociatedGames, GroupGame{AppID: appID, Name: htmlToText(match[2])})
+	}
+
+	g.Links = nil
+	for _, match := range groupLinkRegexp.FindAllStringSubmatch(page, -1) {
+		g.Links = append(g.Links, GroupLink{Title: htmlToText(match[2]), URL: unwrapLinkFilter(htmlToText(match[1]))})
+	}
 }
 
-// PostAnnouncement sends a request to create a new Steam Group announcement.
-// headline specifies the headline of the announcement.
-// content specifies the content of the announcement.
-func (g *Group) PostAnnouncement(headline string, content string) error {
-	resp, err := g.client.postForm(
-		fmt.Sprintf("https://steamcommunity.com/gid/%s/announcements", g.ID),
-		map[string]string{},
-		map[string]string{
-			"sessionID": g.client.SessionID,
-			"action":    "post",
-			"headline":  headline,
-			"body":      content,
-			"languages[0][headline]": headline,
-			"languages[0][body]":     content,
-		},
+// groupMemberList retrieves a single page of a group's member list.
+// path is the group's path on steamcommunity.com, such as "groups/<url>" or "gid/<id>".
+func (c *Client) groupMemberList(ctx context.Context, path string, page int) (*groupMemberList, error) {
+	resp, err := c.getContext(
+		ctx,
+		fmt.Sprintf("https://steamcommunity.com/%s/memberslistxml/?xml=1&p=%d", path, page),
 	)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode == 403 {
-		return errors.New("Unauthenticated")
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
 	}
 
-	if resp.StatusCode != 200 {
-		return errors.New("Unknown error")
+	body, _ := ioutil.ReadAll(resp.Body)
+	var xmlResp groupMemberList
+	err = xml.Unmarshal(body, &xmlResp)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &xmlResp, nil
 }
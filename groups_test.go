@@ -3,6 +3,7 @@ package steamcommunity_test
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	steamcommunity "alex-j-butler.com/steamcommunity"
 
@@ -86,3 +87,144 @@ func (s *ClientTestSuite) TestGroupSuccess() {
 	assert.Equal(s.T(), 0, group.MembersInGame)
 	assert.Equal(s.T(), 0, group.MembersOnline)
 }
+
+func (s *ClientTestSuite) TestGroupByID() {
+	s.login(
+		// Group request.
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "/gid/103582791454641428/memberslistxml/", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+				<memberList>
+					<groupID64>103582791454641428</groupID64>
+					<groupDetails>
+						<groupName><![CDATA[shival]]></groupName>
+						<groupURL><![CDATA[shival]]></groupURL>
+						<memberCount>2</memberCount>
+					</groupDetails>
+					<memberCount>2</memberCount>
+					<totalPages>1</totalPages>
+					<currentPage>1</currentPage>
+					<members>
+						<steamID64>76561198063808035</steamID64>
+						<steamID64>76561198333828103</steamID64>
+					</members>
+				</memberList>
+			`))
+		},
+
+		// Group page request.
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "/gid/103582791454641428", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="grouppage_header_name">
+					shival <span class="grouppage_header_abbrev" >SHVL</span>
+				</div>
+				<div class="group_associated_game_content">
+					<div class="group_associated_game_name"><a class="linkStandard" href="https://steamcommunity.com/app/440">Team Fortress 2</a></div>
+				</div>
+				<div class="groupstat">
+					<div class="label">Founded</div>
+					<div class="data">March 12, 2014</div>
+				</div>
+				<div class="groupstat">
+					<div class="label">Location</div>
+					<div class="data"><img class="countryFlag" src="https://steamcommunity-a.akamaihd.net/public/images/countryflags/au.gif"> Australia</div>
+				</div>
+				<div class="weblink"><a class="linkStandard" href="https://steamcommunity.com/linkfilter/?url=https://example.com/" target="_blank" rel="noreferrer">Website &amp; Forums</a></div>
+			`))
+		},
+	)
+
+	group, err := s.Client.GroupByID("103582791454641428")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "103582791454641428", group.ID)
+	assert.Equal(s.T(), "shival", group.Name)
+	assert.Equal(s.T(), "SHVL", group.Abbreviation)
+	assert.Equal(s.T(), 2, group.MemberCount)
+	assert.Equal(s.T(), time.Date(2014, time.March, 12, 0, 0, 0, 0, time.UTC), group.Founded)
+	assert.Equal(s.T(), "Australia", group.Country)
+	assert.Equal(s.T(), []steamcommunity.GroupGame{{AppID: 440, Name: "Team Fortress 2"}}, group.AssociatedGames)
+	assert.Equal(s.T(), []steamcommunity.GroupLink{{Title: "Website & Forums", URL: "https://example.com/"}}, group.Links)
+}
+
+func (s *ClientTestSuite) TestGroupPageError() {
+	s.login(
+		groupResponse,
+
+		// Group page request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "103582791454641428", group.ID)
+	assert.Equal(s.T(), "shival", group.Name)
+	assert.Empty(s.T(), group.Abbreviation)
+	assert.Nil(s.T(), group.Links)
+}
+
+func (s *ClientTestSuite) TestGroupRefreshPageError() {
+	s.login(
+		groupResponse,
+
+		// Group page request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="grouppage_header_name">
+					shival <span class="grouppage_header_abbrev" >SHVL</span>
+				</div>
+				<div class="weblink"><a class="linkStandard" href="https://example.com/" target="_blank" rel="noreferrer">Website</a></div>
+			`))
+		},
+
+		groupResponse,
+
+		// Refreshed group page request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "SHVL", group.Abbreviation)
+
+	err = group.Refresh()
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/gid/103582791454641428", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "shival", group.Name)
+	assert.Equal(s.T(), "SHVL", group.Abbreviation)
+	assert.Equal(s.T(), []steamcommunity.GroupLink{{Title: "Website", URL: "https://example.com/"}}, group.Links)
+}
+
+// groupResponse serves a minimal member list, used by tests that need a *Group.
+// It is also served in place of the group page.
+func groupResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+		<memberList>
+			<groupID64>103582791454641428</groupID64>
+			<groupDetails>
+				<groupName><![CDATA[shival]]></groupName>
+				<groupURL><![CDATA[shival]]></groupURL>
+				<memberCount>1</memberCount>
+			</groupDetails>
+			<memberCount>1</memberCount>
+			<totalPages>1</totalPages>
+			<currentPage>1</currentPage>
+			<members>
+				<steamID64>76561198063808035</steamID64>
+			</members>
+		</memberList>
+	`))
+}
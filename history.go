@@ -0,0 +1,180 @@
+package steamcommunity
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GroupHistoryType is the type of an entry in a group's history.
+type GroupHistoryType int
+
+const (
+	GroupHistoryOther GroupHistoryType = iota
+	GroupHistoryMemberJoined
+	GroupHistoryMemberLeft
+	GroupHistoryMemberKicked
+	GroupHistoryMemberBanned
+	GroupHistoryRankChanged
+	GroupHistoryInviteSent
+	GroupHistoryAnnouncement
+	GroupHistoryEvent
+)
+
+// groupHistoryTypes maps the labels Steam shows on the history page to their types.
+var groupHistoryTypes = map[string]GroupHistoryType{
+	"New Member":       GroupHistoryMemberJoined,
+	"Member Left":      GroupHistoryMemberLeft,
+	"Kicked Member":    GroupHistoryMemberKicked,
+	"Member Kicked":    GroupHistoryMemberKicked,
+	"Banned Member":    GroupHistoryMemberBanned,
+	"Member Banned":    GroupHistoryMemberBanned,
+	"Rank Changed":     GroupHistoryRankChanged,
+	"Promoted":         GroupHistoryRankChanged,
+	"Demoted":          GroupHistoryRankChanged,
+	"Invite Sent":      GroupHistoryInviteSent,
+	"New Announcement": GroupHistoryAnnouncement,
+	"New Event":        GroupHistoryEvent,
+}
+
+var (
+	historyItemRegexp  = regexp.MustCompile(`<div class="historyItemb?">`)
+	historyShortRegexp = regexp.MustCompile(`(?s)<span class="historyShort">(.*?)</span>`)
+	historyDateRegexp  = regexp.MustCompile(`(?s)<span class="historyDate">(.*?)</span>`)
+	historyUserRegexp  = regexp.MustCompile(`data-miniprofile="(\d+)"`)
+	historyPageRegexp  = regexp.MustCompile(`history\?p=(\d+)`)
+)
+
+// GroupHistoryEntry is an entry in a group's history.
+type GroupHistoryEntry struct {
+	Type GroupHistoryType
+	// Label is the type of the entry as shown by Steam, such as "New Member".
+	Label string
+	Time  time.Time
+	// SteamID is the user the entry is about, such as the member who joined or was kicked.
+	SteamID string
+	// ActorSteamID is the user who performed the action, if it was not SteamID.
+	ActorSteamID string
+	Text         string
+}
+
+// GroupHistoryIterator walks every page of a group's history, newest first.
+type GroupHistoryIterator struct {
+	group   *Group
+	page    int
+	total   int
+	pending []*GroupHistoryEntry
+	current *GroupHistoryEntry
+	err     error
+}
+
+// History retrieves a page of the group's history, starting at page 1, and the total number of pages.
+func (g *Group) History(page int) ([]*GroupHistoryEntry, int, error) {
+	loc := g.client.timeLocation()
+
+	body, err := g.client.getPage(fmt.Sprintf("https://steamcommunity.com/gid/%s/history?p=%d", g.ID, page))
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := page
+	for _, match := range historyPageRegexp.FindAllStringSubmatch(body, -1) {
+		if n, _ := strconv.Atoi(match[1]); n > total {
+			total = n
+		}
+	}
+
+	now := time.Now()
+
+	var entries []*GroupHistoryEntry
+	indices := historyItemRegexp.FindAllStringIndex(body, -1)
+	for i, index := range indices {
+		end := len(body)
+		if i+1 < len(indices) {
+			end = indices[i+1][0]
+		}
+
+		entries = append(entries, parseHistoryItem(body[index[1]:end], loc, now))
+	}
+
+	return entries, total, nil
+}
+
+// HistoryIter returns an iterator over every entry in the group's history.
+func (g *Group) HistoryIter() *GroupHistoryIterator {
+	return &GroupHistoryIterator{group: g}
+}
+
+// Next advances the iterator to the next entry, returning false when there are no more entries or an error occurred.
+func (it *GroupHistoryIterator) Next() bool {
+	for len(it.pending) == 0 {
+		if it.err != nil || (it.page > 0 && it.page >= it.total) {
+			return false
+		}
+
+		it.page++
+		it.pending, it.total, it.err = it.group.History(it.page)
+
+		if it.err != nil {
+			return false
+		}
+	}
+
+	it.current = it.pending[0]
+	it.pending = it.pending[1:]
+
+	return true
+}
+
+// Entry returns the current entry.
+func (it *GroupHistoryIterator) Entry() *GroupHistoryEntry {
+	return it.current
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *GroupHistoryIterator) Err() error {
+	return it.err
+}
+
+// parseHistoryItem parses a single history entry.
+// Users linked after "by" are the actor, and the first user linked before it is the subject.
+func parseHistoryItem(item string, loc *time.Location, now time.Time) *GroupHistoryEntry {
+	entry := &GroupHistoryEntry{}
+
+	if i := strings.Index(item, "</div>"); i >= 0 {
+		item = item[:i]
+	}
+
+	if match := historyShortRegexp.FindStringSubmatch(item); match != nil {
+		entry.Label = htmlToText(match[1])
+		entry.Type = groupHistoryTypes[entry.Label]
+		item = strings.Replace(item, match[0], "", 1)
+	}
+
+	if match := historyDateRegexp.FindStringSubmatch(item); match != nil {
+		entry.Time, _ = parseSteamTime(htmlToText(match[1]), loc, now)
+		item = strings.Replace(item, match[0], "", 1)
+	}
+
+	subject, actor := item, ""
+	if i := strings.LastIndex(item, " by "); i >= 0 {
+		subject, actor = item[:i], item[i:]
+	}
+
+	if match := historyUserRegexp.FindStringSubmatch(subject); match != nil {
+		accountID, _ := strconv.ParseUint(match[1], 10, 32)
+		entry.SteamID = accountIDToSteamID(uint32(accountID))
+	}
+
+	if match := historyUserRegexp.FindStringSubmatch(actor); match != nil {
+		accountID, _ := strconv.ParseUint(match[1], 10, 32)
+		entry.ActorSteamID = accountIDToSteamID(uint32(accountID))
+	}
+
+	entry.Text = strings.Join(strings.Fields(htmlToText(item)), " ")
+
+	return entry
+}
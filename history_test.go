@@ -0,0 +1,82 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestHistoryIter() {
+	pages := map[string]string{
+		"1": `
+			<div class="historyItem">
+				<span class="historyShort">Kicked Member</span>
+				<span class="historyDate">Mar 5, 2016 @ 9:15am</span>
+				<a class="whiteLink" data-miniprofile="373562375" href="https://steamcommunity.com/profiles/76561198333828103">Sam</a> was kicked by <a class="whiteLink" data-miniprofile="103542307" href="https://steamcommunity.com/id/alex">Alex</a>
+			</div>
+			<div class="historyItemb">
+				<span class="historyShort">New Member</span>
+				<span class="historyDate">Mar 4, 2016 @ 10:47pm</span>
+				<a class="whiteLink" data-miniprofile="373562375" href="https://steamcommunity.com/profiles/76561198333828103">Sam</a> joined the group
+			</div>
+			<div class="pageLinks"><a href="https://steamcommunity.com/groups/shival/history?p=2">2</a></div>
+		`,
+		"2": `
+			<div class="historyItem">
+				<span class="historyShort">Rank Changed</span>
+				<span class="historyDate">Feb 1, 2016 @ 1:00pm</span>
+				<a class="whiteLink" data-miniprofile="103542307" href="https://steamcommunity.com/id/alex">Alex</a> was made an officer
+			</div>
+			<div class="pageLinks"><a href="https://steamcommunity.com/groups/shival/history?p=1">1</a></div>
+		`,
+	}
+
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// History requests.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(pages[r.URL.Query().Get("p")]))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	var entries []*steamcommunity.GroupHistoryEntry
+	it := group.HistoryIter()
+	for it.Next() {
+		entries = append(entries, it.Entry())
+	}
+
+	assert.NoError(s.T(), it.Err())
+	assert.Equal(s.T(), []*steamcommunity.GroupHistoryEntry{
+		{
+			Type:         steamcommunity.GroupHistoryMemberKicked,
+			Label:        "Kicked Member",
+			Time:         time.Date(2016, time.March, 5, 9, 15, 0, 0, time.UTC),
+			SteamID:      "76561198333828103",
+			ActorSteamID: "76561198063808035",
+			Text:         "Sam was kicked by Alex",
+		},
+		{
+			Type:    steamcommunity.GroupHistoryMemberJoined,
+			Label:   "New Member",
+			Time:    time.Date(2016, time.March, 4, 22, 47, 0, 0, time.UTC),
+			SteamID: "76561198333828103",
+			Text:    "Sam joined the group",
+		},
+		{
+			Type:    steamcommunity.GroupHistoryRankChanged,
+			Label:   "Rank Changed",
+			Time:    time.Date(2016, time.February, 1, 13, 0, 0, 0, time.UTC),
+			SteamID: "76561198063808035",
+			Text:    "Alex was made an officer",
+		},
+	}, entries)
+}
@@ -0,0 +1,141 @@
+package steamcommunity
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ICSEventDuration is the length given to events in iCalendar exports, as Steam events have no end time.
+const ICSEventDuration = 2 * time.Hour
+
+const icsTimeFormat = "20060102T150405Z"
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// EventsICS returns the group's events starting between from and to as an RFC 5545 iCalendar.
+// Event UIDs are derived from the Steam event IDs, so they are stable between exports.
+// DTSTAMP is the time of the export, as RFC 5545 requires.
+func (g *Group) EventsICS(from time.Time, to time.Time) ([]byte, error) {
+	var events []*GroupEvent
+
+	month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location())
+	for !month.After(to) {
+		monthEvents, err := g.Events(month)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range monthEvents {
+			if !event.Start.Before(from) && event.Start.Before(to) {
+				events = append(events, event)
+			}
+		}
+
+		month = month.AddDate(0, 1, 0)
+	}
+
+	// Steam doesn't say when events were edited, so every export is a new revision of each event.
+	// The sequence number is the export time, which increases between exports so calendar clients pick up edits.
+	now := time.Now().UTC()
+
+	var buf bytes.Buffer
+	writeICSLine(&buf, "BEGIN:VCALENDAR")
+	writeICSLine(&buf, "VERSION:2.0")
+	writeICSLine(&buf, "PRODID:-//go-steamcommunity//Steam Group Events//EN")
+	writeICSLine(&buf, "CALSCALE:GREGORIAN")
+	writeICSLine(&buf, "METHOD:PUBLISH")
+	writeICSLine(&buf, "X-WR-CALNAME:"+icsEscaper.Replace(g.Name))
+
+	for _, event := range events {
+		start := event.Start.UTC()
+
+		description := event.Description
+		if event.ServerIP != "" {
+			connect := "steam://connect/" + event.ServerIP
+			if event.ServerPassword != "" {
+				connect += "/" + event.ServerPassword
+			}
+
+			description = strings.TrimSpace(description + "\n\nConnect: " + connect)
+		}
+
+		writeICSLine(&buf, "BEGIN:VEVENT")
+		writeICSLine(&buf, fmt.Sprintf("UID:%s@steamcommunity.com", event.ID))
+		writeICSLine(&buf, "DTSTAMP:"+now.Format(icsTimeFormat))
+		writeICSLine(&buf, fmt.Sprintf("SEQUENCE:%d", now.Unix()))
+		writeICSLine(&buf, "DTSTART:"+start.Format(icsTimeFormat))
+		writeICSLine(&buf, "DTEND:"+start.Add(ICSEventDuration).Format(icsTimeFormat))
+		writeICSLine(&buf, "SUMMARY:"+icsEscaper.Replace(event.Name))
+
+		if description != "" {
+			writeICSLine(&buf, "DESCRIPTION:"+icsEscaper.Replace(description))
+		}
+
+		if event.URL != "" {
+			writeICSLine(&buf, "URL:"+event.URL)
+		}
+
+		writeICSLine(&buf, "END:VEVENT")
+	}
+
+	writeICSLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes(), nil
+}
+
+// EventsICSHandler serves a group's events as an iCalendar feed.
+type EventsICSHandler struct {
+	Group *Group
+
+	// Before and After are how far before and after the current time events are included. They default to 30 and 90 days.
+	Before time.Duration
+	After  time.Duration
+}
+
+func (h *EventsICSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	before, after := h.Before, h.After
+	if before == 0 {
+		before = 30 * 24 * time.Hour
+	}
+
+	if after == 0 {
+		after = 90 * 24 * time.Hour
+	}
+
+	now := time.Now()
+	calendar, err := h.Group.EventsICS(now.Add(-before), now.Add(after))
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Write(calendar)
+}
+
+// writeICSLine writes a content line, folding it into lines of at most 75 octets as required by RFC 5545.
+func writeICSLine(buf *bytes.Buffer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Don't split a multi-byte UTF-8 sequence.
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = 74
+	}
+
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
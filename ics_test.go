@@ -0,0 +1,58 @@
+package steamcommunity_test
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestEventsICS() {
+	s.login(groupResponse, groupResponse, eventFeedResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	before := time.Now().Truncate(time.Second)
+	loc := time.FixedZone("AEDT", 11*60*60)
+	calendar, err := group.EventsICS(
+		time.Date(2016, time.November, 10, 0, 0, 0, 0, loc),
+		time.Date(2016, time.November, 30, 0, 0, 0, 0, loc),
+	)
+
+	after := time.Now()
+	assert.NoError(s.T(), err)
+
+	// DTSTAMP and SEQUENCE are the export time.
+	stamp := regexp.MustCompile(`DTSTAMP:(\S+)\r\nSEQUENCE:(\d+)`).FindStringSubmatch(string(calendar))
+	s.Require().NotNil(stamp)
+
+	generated, err := time.Parse("20060102T150405Z", stamp[1])
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), generated.Before(before) || generated.After(after))
+	assert.Equal(s.T(), strconv.FormatInt(generated.Unix(), 10), stamp[2])
+
+	assert.Equal(s.T(), strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//go-steamcommunity//Steam Group Events//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:shival",
+		"BEGIN:VEVENT",
+		"UID:3167148133464014123@steamcommunity.com",
+		"DTSTAMP:" + stamp[1],
+		"SEQUENCE:" + stamp[2],
+		"DTSTART:20161118T093000Z",
+		"DTEND:20161118T113000Z",
+		"SUMMARY:Game night & chill",
+		`DESCRIPTION:Bring friends!\n\nConnect: steam://connect/203.0.113.5:27015/hu`,
+		" nter2",
+		"URL:https://steamcommunity.com/groups/shival/events/3167148133464014123",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), string(calendar))
+}
@@ -0,0 +1,124 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var joinRequestTimeRegexp = regexp.MustCompile(`(?s)class="joinRequestTime">(.*?)</div>`)
+
+// JoinRequest is a pending request to join a restricted Steam Group.
+type JoinRequest struct {
+	SteamID   string
+	Name      string
+	Requested time.Time
+}
+
+// JoinRequests retrieves the pending requests to join the group.
+func (g *Group) JoinRequests() ([]*JoinRequest, error) {
+	loc := g.client.timeLocation()
+
+	resp, err := g.client.get(fmt.Sprintf("https://steamcommunity.com/gid/%s/joinRequestsManage", g.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	now := time.Now()
+
+	var requests []*JoinRequest
+	for _, block := range strings.Split(string(body), `<div class="member_block"`)[1:] {
+		match := memberMiniRegexp.FindStringSubmatch(block)
+
+		if match == nil {
+			continue
+		}
+
+		accountID, _ := strconv.ParseUint(match[1], 10, 32)
+		request := &JoinRequest{SteamID: accountIDToSteamID(uint32(accountID))}
+
+		if match := memberNameRegexp.FindStringSubmatch(block); match != nil {
+			request.Name = htmlToText(match[1])
+		}
+
+		if match := joinRequestTimeRegexp.FindStringSubmatch(block); match != nil {
+			requested := strings.TrimPrefix(htmlToText(match[1]), "Requested ")
+			request.Requested, _ = parseSteamTime(requested, loc, now)
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// RespondJoinRequests accepts or denies the join requests from each of steamIDs.
+func (g *Group) RespondJoinRequests(steamIDs []string, accept bool) error {
+	values := url.Values{}
+	values.Set("sessionID", g.client.SessionID)
+	values.Set("json", "1")
+	values.Set("bapprove", boolToForm(accept))
+
+	for _, steamID := range steamIDs {
+		values.Add("rgAccounts[]", steamID)
+	}
+
+	return g.postJoinRequests(values)
+}
+
+// RespondAllJoinRequests accepts or denies every pending join request.
+func (g *Group) RespondAllJoinRequests(accept bool) error {
+	values := url.Values{}
+	values.Set("sessionID", g.client.SessionID)
+	values.Set("json", "1")
+	values.Set("action", "bulkrespond")
+	values.Set("bapprove", boolToForm(accept))
+
+	return g.postJoinRequests(values)
+}
+
+func (g *Group) postJoinRequests(values url.Values) error {
+	resp, err := g.client.postValues(
+		fmt.Sprintf("https://steamcommunity.com/gid/%s/joinRequestsManage", g.ID),
+		map[string]string{},
+		values,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return err
+	}
+
+	// Steam responds with 1 on success.
+	body, _ := ioutil.ReadAll(resp.Body)
+	var result int
+	err = json.Unmarshal(body, &result)
+
+	if err != nil {
+		return err
+	}
+
+	if result != 1 {
+		return fmt.Errorf("steamcommunity: Join request response failed with code %d", result)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestJoinRequests() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Join request management request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="member_block" data-miniprofile="373562375">
+					<div class="member_block_content">
+						<a class="linkFriend" href="https://steamcommunity.com/profiles/76561198333828103">Sam</a>
+					</div>
+					<div class="joinRequestTime">Requested Mar 4, 2016 @ 10:47pm</div>
+				</div>
+			`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	requests, err := group.JoinRequests()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), requests, 1)
+	assert.Equal(s.T(), "76561198333828103", requests[0].SteamID)
+	assert.Equal(s.T(), "Sam", requests[0].Name)
+	assert.True(s.T(), time.Date(2016, time.March, 4, 22, 47, 0, 0, time.UTC).Equal(requests[0].Requested))
+}
+
+func (s *ClientTestSuite) TestRespondJoinRequests() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Join request response.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`1`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	err = group.RespondJoinRequests([]string{"76561198333828103", "76561198000000001"}, true)
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "1", form.Get("bapprove"))
+	assert.Equal(s.T(), []string{"76561198333828103", "76561198000000001"}, form["rgAccounts[]"])
+}
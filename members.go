@@ -0,0 +1,235 @@
+package steamcommunity
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultMembersConcurrency is the number of member list pages requested at once when MembersOptions.Concurrency is not set.
+const DefaultMembersConcurrency = 4
+
+// MembersOptions configures how AllMembers and MembersIter walk a group's member list.
+type MembersOptions struct {
+	// Concurrency is the maximum number of pages requested at once.
+	Concurrency int
+
+	// Progress is called after each page is retrieved with the number of pages retrieved so far and the total number of pages.
+	Progress func(fetched int, total int)
+}
+
+// MemberIterator walks every page of a group's member list, returning each member once.
+type MemberIterator struct {
+	group   *Group
+	parent  context.Context
+	ctx     context.Context
+	cancel  context.CancelFunc
+	opts    MembersOptions
+	pages   chan memberPage
+	started bool
+	fetched int
+	total   int
+	seen    map[string]bool
+	pending []string
+	current string
+	err     error
+
+	// details are the group details from the first page.
+	details groupDetails
+	// updateGroup sets the group's MemberCount from the first page.
+	updateGroup bool
+}
+
+type memberPage struct {
+	members []string
+	err     error
+}
+
+// MembersIter returns an iterator over every member of the group.
+// Pages after the first are retrieved concurrently, so members are not returned in any particular order.
+// opts may be nil to use the defaults. Close must be called if the iterator is not walked to the end.
+func (g *Group) MembersIter(ctx context.Context, opts *MembersOptions) *MemberIterator {
+	return g.membersIter(ctx, opts, true)
+}
+
+// membersIter returns an iterator like MembersIter, which only sets the group's MemberCount if updateGroup is true.
+func (g *Group) membersIter(ctx context.Context, opts *MembersOptions, updateGroup bool) *MemberIterator {
+	it := &MemberIterator{
+		group:       g,
+		parent:      ctx,
+		seen:        make(map[string]bool),
+		updateGroup: updateGroup,
+	}
+
+	if opts != nil {
+		it.opts = *opts
+	}
+
+	if it.opts.Concurrency <= 0 {
+		it.opts.Concurrency = DefaultMembersConcurrency
+	}
+
+	it.ctx, it.cancel = context.WithCancel(ctx)
+
+	return it
+}
+
+// AllMembers retrieves the SteamID64 of every member of the group, walking every page of the member list.
+// opts may be nil to use the defaults.
+func (g *Group) AllMembers(ctx context.Context, opts *MembersOptions) ([]string, error) {
+	it := g.MembersIter(ctx, opts)
+	defer it.Close()
+
+	var members []string
+	for it.Next() {
+		members = append(members, it.SteamID())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// Next advances the iterator to the next member, returning false when there are no more members or an error occurred.
+func (it *MemberIterator) Next() bool {
+	for {
+		for len(it.pending) > 0 {
+			id := it.pending[0]
+			it.pending = it.pending[1:]
+
+			// Members can move between pages while the list is being walked.
+			if !it.seen[id] {
+				it.seen[id] = true
+				it.current = id
+				return true
+			}
+		}
+
+		if it.err != nil || !it.nextPage() {
+			it.Close()
+			return false
+		}
+	}
+}
+
+// SteamID returns the SteamID64 of the current member.
+func (it *MemberIterator) SteamID() string {
+	return it.current
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *MemberIterator) Err() error {
+	return it.err
+}
+
+// Close stops retrieving pages.
+func (it *MemberIterator) Close() {
+	it.cancel()
+}
+
+func (it *MemberIterator) nextPage() bool {
+	if !it.started {
+		it.started = true
+
+		list, err := it.group.client.groupMemberList(it.ctx, it.path(), 1)
+
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.total = list.MemberTotalPages
+		if it.total < 1 {
+			it.total = 1
+		}
+
+		if it.updateGroup {
+			it.group.MemberCount = list.GroupDetails.MemberCount
+		}
+
+		it.details = list.GroupDetails
+		it.pending = list.Members.SteamID64
+		it.fetchRemaining()
+		it.progress()
+
+		return true
+	}
+
+	page, ok := <-it.pages
+
+	if !ok {
+		it.err = it.parent.Err()
+		return false
+	}
+
+	if page.err != nil {
+		it.err = page.err
+		return false
+	}
+
+	it.pending = page.members
+	it.progress()
+
+	return true
+}
+
+// fetchRemaining starts retrieving every page after the first, with at most opts.Concurrency requests at once.
+func (it *MemberIterator) fetchRemaining() {
+	it.pages = make(chan memberPage)
+	pageNumbers := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < it.opts.Concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for n := range pageNumbers {
+				var page memberPage
+				list, err := it.group.client.groupMemberList(it.ctx, it.path(), n)
+
+				if err != nil {
+					page.err = err
+				} else {
+					page.members = list.Members.SteamID64
+				}
+
+				select {
+				case it.pages <- page:
+				case <-it.ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+	loop:
+		for n := 2; n <= it.total; n++ {
+			select {
+			case pageNumbers <- n:
+			case <-it.ctx.Done():
+				break loop
+			}
+		}
+
+		close(pageNumbers)
+		wg.Wait()
+		close(it.pages)
+	}()
+}
+
+func (it *MemberIterator) progress() {
+	it.fetched++
+
+	if it.opts.Progress != nil {
+		it.opts.Progress(it.fetched, it.total)
+	}
+}
+
+func (it *MemberIterator) path() string {
+	return fmt.Sprintf("gid/%s", it.group.ID)
+}
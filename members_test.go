@@ -0,0 +1,103 @@
+package steamcommunity_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func memberListPage(page int, members ...string) string {
+	return fmt.Sprintf(`
+		<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+		<memberList>
+			<groupID64>103582791454641428</groupID64>
+			<groupDetails>
+				<groupName><![CDATA[shival]]></groupName>
+				<groupURL><![CDATA[shival]]></groupURL>
+				<memberCount>5</memberCount>
+			</groupDetails>
+			<memberCount>5</memberCount>
+			<totalPages>3</totalPages>
+			<currentPage>%d</currentPage>
+			<members>
+				<steamID64>%s</steamID64>
+			</members>
+		</memberList>
+	`, page, strings.Join(members, "</steamID64><steamID64>"))
+}
+
+func (s *ClientTestSuite) TestGroupAllMembers() {
+	pages := map[string]string{
+		"1": memberListPage(1, "76561198063808035", "76561198333828103"),
+		"2": memberListPage(2, "76561198333828103", "76561198000000001"),
+		"3": memberListPage(3, "76561198000000002", "76561198000000003"),
+	}
+
+	s.login(
+		// Group request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(pages["1"]))
+		},
+
+		// Member list pages.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(pages[r.URL.Query().Get("p")]))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 5, group.MemberCount)
+	assert.Len(s.T(), group.Members, 2)
+
+	var progress []int
+	members, err := group.AllMembers(context.Background(), &steamcommunity.MembersOptions{
+		Concurrency: 2,
+		Progress: func(fetched int, total int) {
+			assert.Equal(s.T(), 3, total)
+			progress = append(progress, fetched)
+		},
+	})
+
+	sort.Strings(members)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []int{1, 2, 3}, progress)
+	assert.Equal(s.T(), []string{
+		"76561198000000001",
+		"76561198000000002",
+		"76561198000000003",
+		"76561198063808035",
+		"76561198333828103",
+	}, members)
+}
+
+func (s *ClientTestSuite) TestGroupAllMembersError() {
+	s.login(
+		// Group request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(memberListPage(1, "76561198063808035", "76561198333828103")))
+		},
+
+		// Member list pages.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	members, err := group.AllMembers(context.Background(), nil)
+
+	assert.Equal(s.T(), steamcommunity.ErrorUnknown, err)
+	assert.Nil(s.T(), members)
+}
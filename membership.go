@@ -0,0 +1,234 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GroupRank is the rank of a member within a Steam Group.
+type GroupRank int
+
+const (
+	GroupRankMember GroupRank = iota
+	GroupRankModerator
+	GroupRankOfficer
+	GroupRankOwner
+)
+
+var (
+	memberMiniRegexp     = regexp.MustCompile(`data-miniprofile="(\d+)"`)
+	memberRankRegexp     = regexp.MustCompile(`class="rank_icon"[^>]*title="([^"]+)"`)
+	memberNameRegexp     = regexp.MustCompile(`(?s)<a class="linkFriend[^"]*"[^>]*>(.*?)</a>`)
+	memberPageLinkRegexp = regexp.MustCompile(`membersManage\?p=(\d+)`)
+)
+
+func (r GroupRank) String() string {
+	switch r {
+	case GroupRankModerator:
+		return "moderator"
+	case GroupRankOfficer:
+		return "officer"
+	case GroupRankOwner:
+		return "owner"
+	default:
+		return "member"
+	}
+}
+
+// GroupMember is a member of a Steam Group, as shown on the member management page.
+type GroupMember struct {
+	SteamID string
+	Name    string
+	Rank    GroupRank
+}
+
+// MemberActionResult is the result of a bulk membership action for a single user.
+// Err is nil if the action succeeded for SteamID.
+type MemberActionResult struct {
+	SteamID string
+	Err     error
+}
+
+type groupInviteResponse struct {
+	Results string `json:"results"`
+}
+
+// ManagedMembers retrieves every member of the group along with their rank.
+// This requires the logged in user to be able to manage the group's members.
+func (g *Group) ManagedMembers() ([]*GroupMember, error) {
+	var members []*GroupMember
+
+	for page, total := 1, 1; page <= total; page++ {
+		resp, err := g.client.get(fmt.Sprintf("https://steamcommunity.com/gid/%s/membersManage?p=%d", g.ID, page))
+
+		if err != nil {
+			return nil, err
+		}
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if err = checkResponse(resp); err != nil {
+			return nil, err
+		}
+
+		for _, match := range memberPageLinkRegexp.FindAllSubmatch(body, -1) {
+			if n, _ := strconv.Atoi(string(match[1])); n > total {
+				total = n
+			}
+		}
+
+		members = append(members, parseMemberBlocks(string(body))...)
+	}
+
+	return members, nil
+}
+
+// Invite invites each of steamIDs to join the group.
+func (g *Group) Invite(steamIDs []string) []MemberActionResult {
+	return bulkMemberAction(steamIDs, func(steamID string) error {
+		resp, err := g.client.postForm(
+			"https://steamcommunity.com/actions/GroupInvite",
+			map[string]string{},
+			map[string]string{
+				"json":      "1",
+				"type":      "groupInvite",
+				"group":     g.ID,
+				"sessionID": g.client.SessionID,
+				"invitee":   steamID,
+			},
+		)
+
+		if err != nil {
+			return err
+		}
+
+		defer resp.Body.Close()
+
+		if err = checkResponse(resp); err != nil {
+			return err
+		}
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		var inviteResp groupInviteResponse
+		err = json.Unmarshal(body, &inviteResp)
+
+		if err != nil {
+			return err
+		}
+
+		if inviteResp.Results != "OK" {
+			return fmt.Errorf("steamcommunity: %s", inviteResp.Results)
+		}
+
+		return nil
+	})
+}
+
+// Kick removes each of steamIDs from the group.
+func (g *Group) Kick(steamIDs []string) []MemberActionResult {
+	return bulkMemberAction(steamIDs, func(steamID string) error {
+		return g.manageMember("kick", steamID, nil)
+	})
+}
+
+// Ban removes each of steamIDs from the group and prevents them from joining again.
+func (g *Group) Ban(steamIDs []string) []MemberActionResult {
+	return bulkMemberAction(steamIDs, func(steamID string) error {
+		return g.manageMember("ban", steamID, nil)
+	})
+}
+
+// Unban allows each of steamIDs to join the group again.
+func (g *Group) Unban(steamIDs []string) []MemberActionResult {
+	return bulkMemberAction(steamIDs, func(steamID string) error {
+		return g.manageMember("unban", steamID, nil)
+	})
+}
+
+// SetRank changes the rank of a member. The group owner cannot be changed.
+func (g *Group) SetRank(steamID string, rank GroupRank) error {
+	if rank == GroupRankOwner {
+		return errors.New("steamcommunity: Cannot change group owner")
+	}
+
+	return g.manageMember("setRank", steamID, map[string]string{"rank": rank.String()})
+}
+
+// manageMember sends an action to the group's member management page.
+func (g *Group) manageMember(action string, steamID string, extra map[string]string) error {
+	form := map[string]string{
+		"sessionID":   g.client.SessionID,
+		"action":      action,
+		"memberId":    steamID,
+		"queryString": "",
+	}
+
+	for k, v := range extra {
+		form[k] = v
+	}
+
+	resp, err := g.client.postForm(
+		fmt.Sprintf("https://steamcommunity.com/gid/%s/membersManage", g.ID),
+		map[string]string{},
+		form,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// bulkMemberAction runs action for each of steamIDs in turn, collecting the results.
+func bulkMemberAction(steamIDs []string, action func(steamID string) error) []MemberActionResult {
+	results := make([]MemberActionResult, len(steamIDs))
+	for i, steamID := range steamIDs {
+		results[i] = MemberActionResult{SteamID: steamID, Err: action(steamID)}
+	}
+
+	return results
+}
+
+// parseMemberBlocks parses the member blocks used on group management pages.
+func parseMemberBlocks(page string) []*GroupMember {
+	var members []*GroupMember
+
+	for _, block := range strings.Split(page, `<div class="member_block"`)[1:] {
+		match := memberMiniRegexp.FindStringSubmatch(block)
+
+		if match == nil {
+			continue
+		}
+
+		accountID, _ := strconv.ParseUint(match[1], 10, 32)
+		member := &GroupMember{SteamID: accountIDToSteamID(uint32(accountID))}
+
+		if match := memberNameRegexp.FindStringSubmatch(block); match != nil {
+			member.Name = htmlToText(match[1])
+		}
+
+		if match := memberRankRegexp.FindStringSubmatch(block); match != nil {
+			switch match[1] {
+			case "Group Owner":
+				member.Rank = GroupRankOwner
+			case "Group Officer":
+				member.Rank = GroupRankOfficer
+			case "Group Moderator":
+				member.Rank = GroupRankModerator
+			}
+		}
+
+		members = append(members, member)
+	}
+
+	return members
+}
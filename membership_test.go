@@ -0,0 +1,148 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"net/url"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestManagedMembers() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Member management request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="member_block" data-miniprofile="103542307">
+					<div class="rank_icon" title="Group Owner"></div>
+					<div class="member_block_content">
+						<a class="linkFriend" href="https://steamcommunity.com/id/alex">Alex</a>
+					</div>
+				</div>
+				<div class="member_block" data-miniprofile="373562375">
+					<div class="member_block_content">
+						<a class="linkFriend" href="https://steamcommunity.com/profiles/76561198333828103">Sam &amp; co</a>
+					</div>
+				</div>
+			`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	members, err := group.ManagedMembers()
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*steamcommunity.GroupMember{
+		{SteamID: "76561198063808035", Name: "Alex", Rank: steamcommunity.GroupRankOwner},
+		{SteamID: "76561198333828103", Name: "Sam & co", Rank: steamcommunity.GroupRankMember},
+	}, members)
+}
+
+func (s *ClientTestSuite) TestInvite() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Invite requests.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"results":"OK","groupId":"103582791454641428"}`))
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"results":"This user is already a member of this group."}`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	results := group.Invite([]string{"76561198333828103", "76561198063808035"})
+
+	assert.Len(s.T(), results, 2)
+	assert.Equal(s.T(), "76561198333828103", results[0].SteamID)
+	assert.NoError(s.T(), results[0].Err)
+	assert.Equal(s.T(), "76561198063808035", results[1].SteamID)
+	assert.EqualError(s.T(), results[1].Err, "steamcommunity: This user is already a member of this group.")
+}
+
+func (s *ClientTestSuite) TestManageMembers() {
+	var forms []url.Values
+
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Member management requests.
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "/gid/103582791454641428/membersManage", r.URL.Path)
+			forms = append(forms, s.lastForm())
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	results := group.Kick([]string{"76561198333828103", "76561198000000001"})
+	assert.Equal(s.T(), []steamcommunity.MemberActionResult{
+		{SteamID: "76561198333828103"},
+		{SteamID: "76561198000000001"},
+	}, results)
+
+	results = group.Ban([]string{"76561198333828103"})
+	assert.NoError(s.T(), results[0].Err)
+
+	results = group.Unban([]string{"76561198333828103"})
+	assert.NoError(s.T(), results[0].Err)
+
+	err = group.SetRank("76561198333828103", steamcommunity.GroupRankOfficer)
+	assert.NoError(s.T(), err)
+
+	s.Require().Len(forms, 5)
+	for i, expected := range []struct {
+		action   string
+		memberID string
+	}{
+		{"kick", "76561198333828103"},
+		{"kick", "76561198000000001"},
+		{"ban", "76561198333828103"},
+		{"unban", "76561198333828103"},
+		{"setRank", "76561198333828103"},
+	} {
+		assert.Equal(s.T(), expected.action, forms[i].Get("action"))
+		assert.Equal(s.T(), expected.memberID, forms[i].Get("memberId"))
+		assert.Equal(s.T(), s.Client.SessionID, forms[i].Get("sessionID"))
+	}
+
+	assert.Empty(s.T(), forms[0].Get("rank"))
+	assert.Equal(s.T(), "officer", forms[4].Get("rank"))
+
+	// The owner can't be changed, so nothing is sent.
+	err = group.SetRank("76561198333828103", steamcommunity.GroupRankOwner)
+	assert.Error(s.T(), err)
+	assert.Len(s.T(), forms, 5)
+}
+
+func (s *ClientTestSuite) TestManageMembersError() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	results := group.Ban([]string{"76561198333828103"})
+	assert.Error(s.T(), results[0].Err)
+}
@@ -0,0 +1,369 @@
+package steamcommunity
+
+import (
+	"context"
+	"errors"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ModerationAction is what a Moderator does with a comment that breaks a rule.
+// Actions are ordered by severity, and the most severe action of every broken rule is taken.
+type ModerationAction int
+
+const (
+	// ModerationReport only sends the comment to Moderator.Reports.
+	ModerationReport ModerationAction = iota
+	// ModerationDelete deletes the comment.
+	ModerationDelete
+	// ModerationBan deletes the comment and bans its author from Moderator.Group.
+	ModerationBan
+)
+
+// DefaultModerationPageSize is the number of newest comments checked by Moderator.Check when PageSize is not set.
+const DefaultModerationPageSize = 50
+
+var (
+	moderationHrefRegexp = regexp.MustCompile(`(?i)<a\b[^>]*?\shref="([^"]+)"`)
+	moderationLinkRegexp = regexp.MustCompile(`(?i)\bhttps?://[^\s<"]+|\bwww\.[^\s<"]+`)
+)
+
+func (a ModerationAction) String() string {
+	switch a {
+	case ModerationDelete:
+		return "delete"
+	case ModerationBan:
+		return "ban"
+	default:
+		return "report"
+	}
+}
+
+// CommentAuthor is information about a comment's author, used by rules that judge the author rather than the comment.
+type CommentAuthor struct {
+	SteamID string
+	Created time.Time
+	Level   int
+}
+
+// ModerationRule is a rule that comments are checked against.
+type ModerationRule struct {
+	// Name identifies the rule in reports and the audit trail.
+	Name string
+	// Action is taken when a comment breaks the rule.
+	Action ModerationAction
+	// Match reports whether comment breaks the rule.
+	// author looks up the comment's author with Moderator.AuthorLookup, and is only called by rules that need it.
+	Match func(comment *Comment, author func() (*CommentAuthor, error)) (bool, error)
+}
+
+// ModerationRecord is an entry in the audit trail of a Moderator.
+type ModerationRecord struct {
+	Time    time.Time
+	Comment *Comment
+	// Rules are the names of every rule the comment broke.
+	Rules  []string
+	Action ModerationAction
+	// DryRun is true if the action was not taken because the Moderator is in dry run mode.
+	DryRun bool
+	// Err is set if taking the action failed, in which case the comment is checked again.
+	Err error
+}
+
+// Moderator checks new comments in a comment thread against a set of rules.
+type Moderator struct {
+	Thread *CommentThread
+	// Group is the group authors are banned from by ModerationBan.
+	Group *Group
+	Rules []ModerationRule
+
+	// DryRun records what would be done without deleting comments or banning authors.
+	DryRun bool
+
+	// PageSize is the number of newest comments checked by each call to Check.
+	PageSize int
+
+	// AuthorLookup retrieves information about comment authors for rules such as NewAccountRule.
+	AuthorLookup func(steamID string) (*CommentAuthor, error)
+
+	// Reports receives a record for every comment that broke a rule. Sends block, so it must be received from.
+	Reports chan<- ModerationRecord
+
+	// Audit is called with a record for every comment that broke a rule, after the action has been taken.
+	Audit func(record ModerationRecord)
+
+	seen map[string]bool
+}
+
+// Check retrieves the newest comments and moderates those that have not been checked before, oldest first.
+// Comments whose rules could not be evaluated, or whose action failed, are checked again by the next call,
+// and the first such rule error is returned after the remaining comments have been moderated.
+func (m *Moderator) Check() ([]ModerationRecord, error) {
+	if m.seen == nil {
+		m.seen = make(map[string]bool)
+	}
+
+	pageSize := m.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultModerationPageSize
+	}
+
+	page, err := m.Thread.Comments(0, pageSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var records []ModerationRecord
+	var firstErr error
+	for i := len(page.Comments) - 1; i >= 0; i-- {
+		comment := page.Comments[i]
+
+		if m.seen[comment.ID] {
+			continue
+		}
+
+		record, err := m.moderate(comment)
+
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			continue
+		}
+
+		if record == nil || record.Err == nil {
+			m.seen[comment.ID] = true
+		}
+
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+
+	return records, firstErr
+}
+
+// Run moderates new comments every interval until ctx is done. Errors are passed to onError if it is not nil.
+func (m *Moderator) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	return runEvery(ctx, interval, func() error {
+		_, err := m.Check()
+		return err
+	}, onError)
+}
+
+// moderate checks a single comment against every rule, taking the most severe action of the rules it breaks.
+func (m *Moderator) moderate(comment *Comment) (*ModerationRecord, error) {
+	var author *CommentAuthor
+	lookup := func() (*CommentAuthor, error) {
+		if author != nil {
+			return author, nil
+		}
+
+		if m.AuthorLookup == nil {
+			return nil, errors.New("steamcommunity: Moderator.AuthorLookup is not set")
+		}
+
+		var err error
+		author, err = m.AuthorLookup(comment.AuthorSteamID)
+
+		return author, err
+	}
+
+	var record *ModerationRecord
+	for _, rule := range m.Rules {
+		broken, err := rule.Match(comment, lookup)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !broken {
+			continue
+		}
+
+		if record == nil {
+			record = &ModerationRecord{Comment: comment, Action: rule.Action, DryRun: m.DryRun}
+		}
+
+		record.Rules = append(record.Rules, rule.Name)
+
+		if rule.Action > record.Action {
+			record.Action = rule.Action
+		}
+	}
+
+	if record == nil {
+		return nil, nil
+	}
+
+	record.Time = time.Now()
+
+	if !m.DryRun {
+		record.Err = m.take(record.Action, comment)
+	}
+
+	if m.Reports != nil {
+		m.Reports <- *record
+	}
+
+	if m.Audit != nil {
+		m.Audit(*record)
+	}
+
+	return record, nil
+}
+
+// take carries out action on comment.
+func (m *Moderator) take(action ModerationAction, comment *Comment) error {
+	if action == ModerationBan {
+		if m.Group == nil {
+			return errors.New("steamcommunity: Moderator.Group is not set")
+		}
+
+		if err := m.Group.Ban([]string{comment.AuthorSteamID})[0].Err; err != nil {
+			return err
+		}
+	}
+
+	if action >= ModerationDelete {
+		return m.Thread.Delete(comment.ID)
+	}
+
+	return nil
+}
+
+// KeywordRule matches comments containing any of keywords, ignoring case.
+func KeywordRule(name string, action ModerationAction, keywords ...string) ModerationRule {
+	return ModerationRule{
+		Name:   name,
+		Action: action,
+		Match: func(comment *Comment, author func() (*CommentAuthor, error)) (bool, error) {
+			text := strings.ToLower(comment.Text)
+			for _, keyword := range keywords {
+				if strings.Contains(text, strings.ToLower(keyword)) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	}
+}
+
+// RegexpRule matches comments whose text matches any of exprs.
+func RegexpRule(name string, action ModerationAction, exprs ...*regexp.Regexp) ModerationRule {
+	return ModerationRule{
+		Name:   name,
+		Action: action,
+		Match: func(comment *Comment, author func() (*CommentAuthor, error)) (bool, error) {
+			for _, expr := range exprs {
+				if expr.MatchString(comment.Text) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	}
+}
+
+// LinkRule matches comments containing links, unless the link's host is one of allowedHosts or a subdomain of one.
+// Links through Steam's link filter are judged by their destination.
+func LinkRule(name string, action ModerationAction, allowedHosts ...string) ModerationRule {
+	return ModerationRule{
+		Name:   name,
+		Action: action,
+		Match: func(comment *Comment, author func() (*CommentAuthor, error)) (bool, error) {
+			// Only links and the visible text are checked, as emoticons and other images are served from Steam's CDN.
+			var links []string
+			for _, match := range moderationHrefRegexp.FindAllStringSubmatch(comment.HTML, -1) {
+				links = append(links, html.UnescapeString(match[1]))
+			}
+
+			links = append(links, moderationLinkRegexp.FindAllString(comment.Text, -1)...)
+
+			for _, link := range links {
+				link = unwrapLinkFilter(link)
+				if !strings.Contains(link, "://") {
+					link = "http://" + link
+				}
+
+				u, err := url.Parse(link)
+
+				if err != nil || !hostAllowed(u.Hostname(), allowedHosts) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	}
+}
+
+// NewAccountRule matches comments from accounts created less than minAge ago, or below minLevel.
+// A zero minAge or minLevel disables that check. This requires Moderator.AuthorLookup.
+func NewAccountRule(name string, action ModerationAction, minAge time.Duration, minLevel int) ModerationRule {
+	return ModerationRule{
+		Name:   name,
+		Action: action,
+		Match: func(comment *Comment, author func() (*CommentAuthor, error)) (bool, error) {
+			a, err := author()
+
+			if err != nil {
+				return false, err
+			}
+
+			if minAge > 0 && !a.Created.IsZero() && time.Since(a.Created) < minAge {
+				return true, nil
+			}
+
+			return minLevel > 0 && a.Level < minLevel, nil
+		},
+	}
+}
+
+// RateRule matches comments from authors who have posted more than maxPosts comments within window.
+// Only comments checked by the Moderator are counted, and a comment checked again is only counted once.
+// The rule is safe to share between Moderators.
+func RateRule(name string, action ModerationAction, maxPosts int, window time.Duration) ModerationRule {
+	var mu sync.Mutex
+	posts := make(map[string]map[string]time.Time)
+
+	return ModerationRule{
+		Name:   name,
+		Action: action,
+		Match: func(comment *Comment, author func() (*CommentAuthor, error)) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			recent := map[string]time.Time{comment.ID: comment.Time}
+			for id, t := range posts[comment.AuthorSteamID] {
+				if comment.Time.Sub(t) < window {
+					recent[id] = t
+				}
+			}
+
+			posts[comment.AuthorSteamID] = recent
+
+			return len(recent) > maxPosts, nil
+		},
+	}
+}
+
+func hostAllowed(host string, allowedHosts []string) bool {
+	host = strings.ToLower(host)
+	for _, allowed := range allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+
+	return false
+}
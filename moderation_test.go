@@ -0,0 +1,199 @@
+package steamcommunity_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestModerator() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		commentsResponse,
+
+		// Delete request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"start":0,"total_count":1,"comments_html":""}`))
+		},
+
+		commentsResponse,
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	var audit []steamcommunity.ModerationRecord
+	moderator := &steamcommunity.Moderator{
+		Thread: group.CommentThread(),
+		Group:  group,
+		Rules: []steamcommunity.ModerationRule{
+			steamcommunity.KeywordRule("free skins", steamcommunity.ModerationReport, "free skins"),
+			steamcommunity.LinkRule("links", steamcommunity.ModerationDelete, "steamcommunity.com", "steampowered.com"),
+		},
+		Audit: func(record steamcommunity.ModerationRecord) {
+			audit = append(audit, record)
+		},
+	}
+
+	records, err := moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), records, 1)
+	assert.Equal(s.T(), "1500000000000000002", records[0].Comment.ID)
+	assert.Equal(s.T(), []string{"free skins", "links"}, records[0].Rules)
+	assert.Equal(s.T(), steamcommunity.ModerationDelete, records[0].Action)
+	assert.NoError(s.T(), records[0].Err)
+	assert.Equal(s.T(), records, audit)
+	assert.Equal(s.T(), "/comment/Clan/delete/103582791454641428/-1/", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "1500000000000000002", s.lastForm().Get("gidcomment"))
+
+	// Comments are only moderated once.
+	records, err = moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), records)
+}
+
+func (s *ClientTestSuite) TestModeratorRetry() {
+	s.login(
+		groupResponse,
+		groupResponse,
+		commentsResponse,
+
+		// Failed delete request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+
+		commentsResponse,
+
+		// Delete request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":true,"start":0,"total_count":1,"comments_html":""}`))
+		},
+
+		commentsResponse,
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	moderator := &steamcommunity.Moderator{
+		Thread: group.CommentThread(),
+		Group:  group,
+		Rules: []steamcommunity.ModerationRule{
+			steamcommunity.LinkRule("links", steamcommunity.ModerationDelete, "steamcommunity.com", "steampowered.com"),
+			steamcommunity.RateRule("rate", steamcommunity.ModerationReport, 1, time.Hour),
+		},
+	}
+
+	records, err := moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), records, 1)
+	assert.Error(s.T(), records[0].Err)
+
+	// The comment whose delete failed is moderated again, without counting it twice towards the rate limit.
+	records, err = moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), records, 1)
+	assert.Equal(s.T(), "1500000000000000002", records[0].Comment.ID)
+	assert.Equal(s.T(), []string{"links"}, records[0].Rules)
+	assert.NoError(s.T(), records[0].Err)
+	assert.Equal(s.T(), "1500000000000000002", s.lastForm().Get("gidcomment"))
+
+	records, err = moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), records)
+}
+
+func (s *ClientTestSuite) TestModeratorEmoticons() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Comments request.
+		func(w http.ResponseWriter, r *http.Request) {
+			body, _ := json.Marshal(map[string]interface{}{
+				"success":     true,
+				"start":       0,
+				"pagesize":    6,
+				"total_count": 2,
+				"comments_html": `
+					<div class="commentthread_comment responsive_body_text" id="comment_1500000000000000004">
+						<div class="commentthread_comment_text" id="comment_content_1500000000000000004">
+							go to http://free-skins.example
+						</div>
+					</div>
+					<div class="commentthread_comment responsive_body_text" id="comment_1500000000000000003">
+						<div class="commentthread_comment_text" id="comment_content_1500000000000000003">
+							GG <img src="https://community.cloudflare.steamstatic.com/economy/emoticon/steamhappy" alt=":steamhappy:" class="emoticon">
+						</div>
+					</div>
+				`,
+			})
+
+			w.WriteHeader(http.StatusOK)
+			w.Write(body)
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	moderator := &steamcommunity.Moderator{
+		Thread: group.CommentThread(),
+		DryRun: true,
+		Rules: []steamcommunity.ModerationRule{
+			steamcommunity.LinkRule("links", steamcommunity.ModerationDelete, "steamcommunity.com", "steampowered.com"),
+		},
+	}
+
+	records, err := moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), records, 1)
+	assert.Equal(s.T(), "1500000000000000004", records[0].Comment.ID)
+}
+
+func (s *ClientTestSuite) TestModeratorDryRun() {
+	s.login(groupResponse, groupResponse, commentsResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	moderator := &steamcommunity.Moderator{
+		Thread: group.CommentThread(),
+		Group:  group,
+		DryRun: true,
+		Rules: []steamcommunity.ModerationRule{
+			steamcommunity.NewAccountRule("low level", steamcommunity.ModerationBan, 0, 5),
+		},
+		AuthorLookup: func(steamID string) (*steamcommunity.CommentAuthor, error) {
+			level := 10
+			if steamID == "76561198333828103" {
+				level = 1
+			}
+
+			return &steamcommunity.CommentAuthor{SteamID: steamID, Level: level}, nil
+		},
+	}
+
+	records, err := moderator.Check()
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), records, 1)
+	assert.Equal(s.T(), "76561198333828103", records[0].Comment.AuthorSteamID)
+	assert.Equal(s.T(), steamcommunity.ModerationBan, records[0].Action)
+	assert.True(s.T(), records[0].DryRun)
+	assert.Equal(s.T(), "/comment/Clan/render/103582791454641428/-1/", s.LastRequest.URL.Path)
+}
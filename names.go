@@ -0,0 +1,79 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NameHistoryEntry is a persona name a user has previously used.
+type NameHistoryEntry struct {
+	Name string
+	// Changed is when the user changed to Name.
+	Changed time.Time
+}
+
+type aliasResponse struct {
+	NewName     string `json:"newname"`
+	TimeChanged string `json:"timechanged"`
+}
+
+type setNicknameResponse struct {
+	Success steamBool `json:"success"`
+	Error   string    `json:"errmsg"`
+}
+
+// NameHistory retrieves the persona names the user with the given SteamID64 has used, newest first.
+// Steam only keeps the most recent names.
+func (c *Client) NameHistory(steamID string) ([]*NameHistoryEntry, error) {
+	loc := c.timeLocation()
+
+	body, err := c.getPage(fmt.Sprintf("https://steamcommunity.com/profiles/%s/ajaxaliases", steamID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var aliases []aliasResponse
+	if err = json.Unmarshal([]byte(body), &aliases); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	history := make([]*NameHistoryEntry, len(aliases))
+	for i, alias := range aliases {
+		history[i] = &NameHistoryEntry{Name: alias.NewName}
+		history[i].Changed, _ = parseSteamTime(alias.TimeChanged, loc, now)
+	}
+
+	return history, nil
+}
+
+// SetNickname sets the nickname shown for a friend with the given SteamID64. An empty nickname removes it.
+func (c *Client) SetNickname(steamID string, nickname string) error {
+	var nicknameResp setNicknameResponse
+	err := c.postFormJSON(
+		fmt.Sprintf("https://steamcommunity.com/profiles/%s/ajaxsetnickname/", steamID),
+		map[string]string{
+			"sessionid": c.SessionID,
+			"nickname":  nickname,
+		},
+		&nicknameResp,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if !nicknameResp.Success {
+		if nicknameResp.Error != "" {
+			return fmt.Errorf("steamcommunity: %s", nicknameResp.Error)
+		}
+
+		return errors.New("steamcommunity: Failed to set nickname")
+	}
+
+	return nil
+}
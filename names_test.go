@@ -0,0 +1,48 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestNameHistory() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"newname":"shival","timechanged":"19 Oct, 2016 @ 3:45pm"},{"newname":"shival2","timechanged":"Jan 2, 2015 @ 9:05am"}]`))
+	})
+
+	history, err := s.Client.NameHistory("76561198063808035")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*steamcommunity.NameHistoryEntry{
+		{Name: "shival", Changed: time.Date(2016, time.October, 19, 15, 45, 0, 0, time.UTC)},
+		{Name: "shival2", Changed: time.Date(2015, time.January, 2, 9, 5, 0, 0, time.UTC)},
+	}, history)
+	assert.Equal(s.T(), "/profiles/76561198063808035/ajaxaliases", s.LastRequest.URL.Path)
+}
+
+func (s *ClientTestSuite) TestSetNickname() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":1,"nickname":"troublemaker"}`))
+	})
+
+	assert.NoError(s.T(), s.Client.SetNickname("76561198063808036", "troublemaker"))
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "troublemaker", form.Get("nickname"))
+	assert.Equal(s.T(), "/profiles/76561198063808036/ajaxsetnickname/", s.LastRequest.URL.Path)
+}
+
+func (s *ClientTestSuite) TestSetNicknameNotFriend() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":0,"errmsg":"You can only set nicknames for friends."}`))
+	})
+
+	err := s.Client.SetNickname("76561198063808036", "troublemaker")
+	assert.EqualError(s.T(), err, "steamcommunity: You can only set nicknames for friends.")
+}
@@ -1,27 +1,67 @@
 package steamcommunity
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
-	"log"
 )
 
-func (c *Client) GetNotifications() error {
+// NotificationCounts are the numbers of unread notifications shown in the Steam Community header.
+type NotificationCounts struct {
+	TradeOffers        int
+	AsyncGame          int
+	ModeratorMessages  int
+	Comments           int
+	Items              int
+	Invites            int
+	Gifts              int
+	OfflineMessages    int
+	HelpRequestReplies int
+}
+
+type notificationCountsResponse struct {
+	Success steamBool `json:"success"`
+	// Notifications maps Steam's notification types to their counts.
+	Notifications map[string]int `json:"notifications"`
+}
+
+// GetNotifications retrieves the logged in user's unread notification counts.
+func (c *Client) GetNotifications() (*NotificationCounts, error) {
 	resp, err := c.get("https://steamcommunity.com/actions/GetNotificationCounts")
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode == 403 {
-		return errors.New("Unauthenticated")
-	}
+	defer resp.Body.Close()
 
-	log.Println("Response:", resp)
-	log.Println("Error:", err)
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
 
 	body, _ := ioutil.ReadAll(resp.Body)
-	log.Println("Body:", string(body))
+	var countsResp notificationCountsResponse
+	err = json.Unmarshal(body, &countsResp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !countsResp.Success {
+		return nil, errors.New("steamcommunity: Failed to retrieve notifications")
+	}
+
+	n := countsResp.Notifications
 
-	return nil
+	return &NotificationCounts{
+		TradeOffers:        n["1"],
+		AsyncGame:          n["2"],
+		ModeratorMessages:  n["3"],
+		Comments:           n["4"],
+		Items:              n["5"],
+		Invites:            n["6"],
+		Gifts:              n["8"],
+		OfflineMessages:    n["9"],
+		HelpRequestReplies: n["10"],
+	}, nil
 }
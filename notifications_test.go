@@ -0,0 +1,41 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestGetNotifications() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":1,"notifications":{"1":2,"2":0,"3":1,"4":5,"5":3,"6":4,"8":1,"9":7,"10":1,"11":0}}`))
+	})
+
+	counts, err := s.Client.GetNotifications()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &steamcommunity.NotificationCounts{
+		TradeOffers:        2,
+		AsyncGame:          0,
+		ModeratorMessages:  1,
+		Comments:           5,
+		Items:              3,
+		Invites:            4,
+		Gifts:              1,
+		OfflineMessages:    7,
+		HelpRequestReplies: 1,
+	}, counts)
+	assert.Equal(s.T(), "/actions/GetNotificationCounts", s.LastRequest.URL.Path)
+}
+
+func (s *ClientTestSuite) TestGetNotificationsNotLoggedIn() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	counts, err := s.Client.GetNotifications()
+	assert.Equal(s.T(), steamcommunity.ErrorNotLoggedIn, err)
+	assert.Nil(s.T(), counts)
+}
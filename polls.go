@@ -0,0 +1,156 @@
+package steamcommunity
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	pollRegexp         = regexp.MustCompile(`<div class="group_poll[ "][^>]*id="poll_(\d+)"`)
+	pollClassRegexp    = regexp.MustCompile(`^<div class="([^"]*)"`)
+	pollQuestionRegexp = regexp.MustCompile(`(?s)class="pollQuestion">(.*?)</div>`)
+	pollOptionRegexp   = regexp.MustCompile(`(?s)class="pollOptionText">(.*?)</span>.*?class="pollOptionVotes">\s*([\d,]+)`)
+	pollEndsRegexp     = regexp.MustCompile(`class="pollEnds"[^>]*data-timestamp="(\d+)"`)
+)
+
+// Poll is a poll of a Steam Group.
+type Poll struct {
+	ID       string
+	Question string
+	Options  []PollOption
+	// Votes is the total number of votes across every option.
+	Votes  int
+	Ends   time.Time
+	Closed bool
+	URL    string
+}
+
+// PollOption is one of the answers to a poll, and the number of votes it received.
+type PollOption struct {
+	Text  string
+	Votes int
+}
+
+// Polls retrieves the group's polls, newest first.
+func (g *Group) Polls() ([]*Poll, error) {
+	body, err := g.client.getPage(g.pollsURL())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return g.parsePolls(body), nil
+}
+
+// CreatePoll creates a poll with at least two options, which closes after duration.
+// Steam only supports whole days, so duration is rounded up to the next day.
+func (g *Group) CreatePoll(question string, options []string, duration time.Duration) (*Poll, error) {
+	if len(options) < 2 {
+		return nil, errors.New("steamcommunity: A poll needs at least two options")
+	}
+
+	days := int((duration + 24*time.Hour - 1) / (24 * time.Hour))
+	if days < 1 {
+		days = 1
+	}
+
+	form := map[string]string{
+		"sessionID": g.client.SessionID,
+		"action":    "create",
+		"question":  question,
+		"duration":  strconv.Itoa(days),
+	}
+
+	for i, option := range options {
+		form[fmt.Sprintf("option_%d", i+1)] = option
+	}
+
+	resp, err := g.client.postForm(g.pollsURL(), map[string]string{}, form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// Steam redirects to the poll listing, newest first, so the new poll is the newest open one with its question.
+	// Closed polls can't be new, even if an older poll asked the same question.
+	body, _ := ioutil.ReadAll(resp.Body)
+	for _, poll := range g.parsePolls(string(body)) {
+		if !poll.Closed && strings.TrimSpace(poll.Question) == strings.TrimSpace(question) {
+			return poll, nil
+		}
+	}
+
+	return nil, errors.New("steamcommunity: Poll missing from response")
+}
+
+// ClosePoll closes a poll to further votes.
+func (g *Group) ClosePoll(pollID string) error {
+	resp, err := g.client.postForm(g.pollsURL(), map[string]string{}, map[string]string{
+		"sessionID": g.client.SessionID,
+		"action":    "close",
+		"pollID":    pollID,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+func (g *Group) pollsURL() string {
+	return fmt.Sprintf("https://steamcommunity.com/gid/%s/polls", g.ID)
+}
+
+// parsePolls parses the polls on a group's poll listing.
+func (g *Group) parsePolls(page string) []*Poll {
+	var polls []*Poll
+
+	indices := pollRegexp.FindAllStringSubmatchIndex(page, -1)
+	for i, index := range indices {
+		end := len(page)
+		if i+1 < len(indices) {
+			end = indices[i+1][0]
+		}
+
+		block := page[index[0]:end]
+		poll := &Poll{ID: page[index[2]:index[3]]}
+		poll.URL = fmt.Sprintf("%s/%s", g.pollsURL(), poll.ID)
+
+		if match := pollClassRegexp.FindStringSubmatch(block); match != nil {
+			poll.Closed = strings.Contains(" "+match[1]+" ", " closed ")
+		}
+
+		if match := pollQuestionRegexp.FindStringSubmatch(block); match != nil {
+			poll.Question = htmlToText(match[1])
+		}
+
+		for _, match := range pollOptionRegexp.FindAllStringSubmatch(block, -1) {
+			votes, _ := strconv.Atoi(strings.Replace(match[2], ",", "", -1))
+			poll.Options = append(poll.Options, PollOption{Text: htmlToText(match[1]), Votes: votes})
+			poll.Votes += votes
+		}
+
+		if match := pollEndsRegexp.FindStringSubmatch(block); match != nil {
+			timestamp, _ := strconv.ParseInt(match[1], 10, 64)
+			poll.Ends = time.Unix(timestamp, 0)
+		}
+
+		polls = append(polls, poll)
+	}
+
+	return polls
+}
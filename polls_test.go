@@ -0,0 +1,119 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pollsResponse serves a group's poll listing with an open and a closed poll.
+func pollsResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`
+		<div class="group_poll" id="poll_200">
+			<div class="pollQuestion">Next map?</div>
+			<div class="pollOption"><span class="pollOptionText">cp_badlands</span> <span class="pollOptionVotes">1,204 votes</span></div>
+			<div class="pollOption"><span class="pollOptionText">koth_product</span> <span class="pollOptionVotes">96 votes</span></div>
+			<div class="pollEnds" data-timestamp="1500000000">Ends in 3 days</div>
+		</div>
+		<div class="group_poll closed" id="poll_100">
+			<div class="pollQuestion">Season start &amp; format</div>
+			<div class="pollOption"><span class="pollOptionText">6v6</span> <span class="pollOptionVotes">3 votes</span></div>
+			<div class="pollOption"><span class="pollOptionText">Highlander</span> <span class="pollOptionVotes">0 votes</span></div>
+		</div>
+	`))
+}
+
+func (s *ClientTestSuite) TestPolls() {
+	s.login(groupResponse, groupResponse, pollsResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	polls, err := group.Polls()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), polls, 2)
+
+	assert.Equal(s.T(), "200", polls[0].ID)
+	assert.Equal(s.T(), "Next map?", polls[0].Question)
+	assert.Equal(s.T(), []steamcommunity.PollOption{
+		{Text: "cp_badlands", Votes: 1204},
+		{Text: "koth_product", Votes: 96},
+	}, polls[0].Options)
+	assert.Equal(s.T(), 1300, polls[0].Votes)
+	assert.Equal(s.T(), int64(1500000000), polls[0].Ends.Unix())
+	assert.False(s.T(), polls[0].Closed)
+
+	assert.Equal(s.T(), "Season start & format", polls[1].Question)
+	assert.True(s.T(), polls[1].Closed)
+	assert.True(s.T(), polls[1].Ends.IsZero())
+}
+
+func (s *ClientTestSuite) TestCreatePoll() {
+	s.login(groupResponse, groupResponse, pollsResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	poll, err := group.CreatePoll("Next map?", []string{"cp_badlands", "koth_product"}, 60*time.Hour)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "200", poll.ID)
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "create", form.Get("action"))
+	assert.Equal(s.T(), "Next map?", form.Get("question"))
+	assert.Equal(s.T(), "cp_badlands", form.Get("option_1"))
+	assert.Equal(s.T(), "koth_product", form.Get("option_2"))
+	assert.Equal(s.T(), "3", form.Get("duration"))
+
+	_, err = group.CreatePoll("Best class?", []string{"Scout", "Medic"}, time.Hour)
+	assert.Error(s.T(), err)
+
+	// A closed poll with the same question is an older poll, not the new one.
+	_, err = group.CreatePoll("Season start & format", []string{"6v6", "Highlander"}, time.Hour)
+	assert.Error(s.T(), err)
+
+	_, err = group.CreatePoll("Next map?", []string{"cp_badlands"}, time.Hour)
+	assert.Error(s.T(), err)
+}
+
+func (s *ClientTestSuite) TestCreatePollRepeatedQuestion() {
+	s.login(groupResponse, groupResponse, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`
+			<div class="group_poll" id="poll_300">
+				<div class="pollQuestion">Next map?</div>
+				<div class="pollOption"><span class="pollOptionText">pl_upward</span> <span class="pollOptionVotes">0 votes</span></div>
+				<div class="pollOption"><span class="pollOptionText">cp_process</span> <span class="pollOptionVotes">0 votes</span></div>
+			</div>
+			<div class="group_poll closed" id="poll_200">
+				<div class="pollQuestion">Next map?</div>
+				<div class="pollOption"><span class="pollOptionText">cp_badlands</span> <span class="pollOptionVotes">1,204 votes</span></div>
+			</div>
+		`))
+	})
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	poll, err := group.CreatePoll("Next map?", []string{"pl_upward", "cp_process"}, time.Hour)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "300", poll.ID)
+	assert.False(s.T(), poll.Closed)
+}
+
+func (s *ClientTestSuite) TestClosePoll() {
+	s.login(groupResponse, groupResponse, pollsResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	assert.NoError(s.T(), group.ClosePoll("200"))
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "close", form.Get("action"))
+	assert.Equal(s.T(), "200", form.Get("pollID"))
+}
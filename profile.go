@@ -0,0 +1,242 @@
+package steamcommunity
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrorProfilePrivate  = errors.New("steamcommunity: Profile is private")
+	ErrorProfileNotFound = errors.New("steamcommunity: Profile not found")
+)
+
+var profileLevelRegexp = regexp.MustCompile(`class="friendPlayerLevelNum">\s*(\d+)`)
+
+// ProfilePrivacy is who can see a user's profile.
+type ProfilePrivacy string
+
+const (
+	ProfilePublic      ProfilePrivacy = "public"
+	ProfileFriendsOnly ProfilePrivacy = "friendsonly"
+	ProfilePrivate     ProfilePrivacy = "private"
+)
+
+// ProfileOnlineState is whether a user is online, and if they are playing a game.
+type ProfileOnlineState string
+
+const (
+	ProfileOffline ProfileOnlineState = "offline"
+	ProfileOnline  ProfileOnlineState = "online"
+	ProfileInGame  ProfileOnlineState = "in-game"
+)
+
+// Profile is a Steam Community user profile.
+type Profile struct {
+	SteamID     string
+	PersonaName string
+	RealName    string
+	// CustomURL is the profile's vanity URL, if it has one.
+	CustomURL    string
+	AvatarIcon   string
+	AvatarMedium string
+	AvatarFull   string
+	Privacy      ProfilePrivacy
+	OnlineState  ProfileOnlineState
+	// StateMessage is the status shown on the profile, such as "Online" or "In-Game<br/>Team Fortress 2".
+	StateMessage string
+	// InGame is the game the user is playing, or nil if they are not in-game.
+	InGame *ProfileGame
+	// InGameServerIP is the address of the server the user is playing on, if any.
+	InGameServerIP string
+	MemberSince    time.Time
+	VACBanned      bool
+	// TradeBanState is "None" unless the user is trade banned or on trade probation.
+	TradeBanState  string
+	LimitedAccount bool
+	// Location is empty for profiles from the Web API, which only provide CountryCode.
+	Location string
+	// CountryCode is the profile's ISO 3166 country code. It is only populated by the Web API.
+	CountryCode     string
+	Headline        string
+	Summary         string
+	Level           int
+	Groups          []UserGroup
+	MostPlayedGames []ProfileGame
+}
+
+// ProfileGame is a game shown on a profile.
+type ProfileGame struct {
+	Name string
+	URL  string
+	Icon string
+	Logo string
+	// HoursPlayed is the hours played in the last two weeks, and HoursOnRecord is the total.
+	// They are only set for most played games.
+	HoursPlayed   float64
+	HoursOnRecord float64
+}
+
+type profileXML struct {
+	Error            string            `xml:"error"`
+	SteamID64        string            `xml:"steamID64"`
+	SteamID          string            `xml:"steamID"`
+	OnlineState      string            `xml:"onlineState"`
+	StateMessage     string            `xml:"stateMessage"`
+	PrivacyState     string            `xml:"privacyState"`
+	AvatarIcon       string            `xml:"avatarIcon"`
+	AvatarMedium     string            `xml:"avatarMedium"`
+	AvatarFull       string            `xml:"avatarFull"`
+	VACBanned        int               `xml:"vacBanned"`
+	TradeBanState    string            `xml:"tradeBanState"`
+	IsLimitedAccount int               `xml:"isLimitedAccount"`
+	CustomURL        string            `xml:"customURL"`
+	InGameServerIP   string            `xml:"inGameServerIP"`
+	InGameInfo       *profileGameXML   `xml:"inGameInfo"`
+	MemberSince      string            `xml:"memberSince"`
+	Headline         string            `xml:"headline"`
+	Location         string            `xml:"location"`
+	RealName         string            `xml:"realname"`
+	Summary          string            `xml:"summary"`
+	MostPlayedGames  []profileGameXML  `xml:"mostPlayedGames>mostPlayedGame"`
+	Groups           []profileGroupXML `xml:"groups>group"`
+}
+
+type profileGameXML struct {
+	GameName      string `xml:"gameName"`
+	GameLink      string `xml:"gameLink"`
+	GameIcon      string `xml:"gameIcon"`
+	GameLogo      string `xml:"gameLogo"`
+	HoursPlayed   string `xml:"hoursPlayed"`
+	HoursOnRecord string `xml:"hoursOnRecord"`
+}
+
+type profileGroupXML struct {
+	IsPrimary int    `xml:"isPrimary,attr"`
+	GroupID64 string `xml:"groupID64"`
+	GroupName string `xml:"groupName"`
+	GroupURL  string `xml:"groupURL"`
+}
+
+// Profile retrieves the profile of the user with the given SteamID64.
+// If the profile is not visible to the logged in user, the returned Profile only contains the fields Steam still shows, and the error is ErrorProfilePrivate.
+func (c *Client) Profile(steamID string) (*Profile, error) {
+	return c.profile(context.Background(), steamID, nil)
+}
+
+// profile retrieves a profile like Profile, cancelling requests when ctx is done.
+// If wait is not nil, it is called before each request, and its error stops the retrieval.
+func (c *Client) profile(ctx context.Context, steamID string, wait func() error) (*Profile, error) {
+	uri := fmt.Sprintf("https://steamcommunity.com/profiles/%s", steamID)
+
+	if wait == nil {
+		wait = func() error { return nil }
+	}
+
+	if err := wait(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.getPageContext(ctx, uri+"/?xml=1")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var xmlResp profileXML
+	if err = xml.Unmarshal([]byte(body), &xmlResp); err != nil {
+		return nil, err
+	}
+
+	if xmlResp.Error != "" || xmlResp.SteamID64 == "" {
+		return nil, ErrorProfileNotFound
+	}
+
+	profile := xmlResp.profile()
+
+	// Friends only profiles are visible to friends, in which case the details are present.
+	if profile.Privacy != ProfilePublic && xmlResp.MemberSince == "" {
+		return profile, ErrorProfilePrivate
+	}
+
+	// The level is only shown on the profile page.
+	if err = wait(); err != nil {
+		return nil, err
+	}
+
+	page, err := c.getPageContext(ctx, uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if match := profileLevelRegexp.FindStringSubmatch(page); match != nil {
+		profile.Level, _ = strconv.Atoi(match[1])
+	}
+
+	return profile, nil
+}
+
+// profile converts the profile XML to a Profile.
+func (x *profileXML) profile() *Profile {
+	profile := &Profile{
+		SteamID:        x.SteamID64,
+		PersonaName:    x.SteamID,
+		RealName:       x.RealName,
+		CustomURL:      x.CustomURL,
+		AvatarIcon:     x.AvatarIcon,
+		AvatarMedium:   x.AvatarMedium,
+		AvatarFull:     x.AvatarFull,
+		Privacy:        ProfilePrivacy(x.PrivacyState),
+		OnlineState:    ProfileOnlineState(x.OnlineState),
+		StateMessage:   x.StateMessage,
+		InGameServerIP: x.InGameServerIP,
+		VACBanned:      x.VACBanned != 0,
+		TradeBanState:  x.TradeBanState,
+		LimitedAccount: x.IsLimitedAccount != 0,
+		Location:       x.Location,
+		Headline:       x.Headline,
+		Summary:        x.Summary,
+	}
+
+	profile.MemberSince, _ = parseSteamDate(x.MemberSince, time.UTC)
+
+	if x.InGameInfo != nil && x.InGameInfo.GameName != "" {
+		game := x.InGameInfo.game()
+		profile.InGame = &game
+	}
+
+	for _, game := range x.MostPlayedGames {
+		profile.MostPlayedGames = append(profile.MostPlayedGames, game.game())
+	}
+
+	for _, group := range x.Groups {
+		profile.Groups = append(profile.Groups, UserGroup{
+			ID:      group.GroupID64,
+			Name:    group.GroupName,
+			URL:     group.GroupURL,
+			Primary: group.IsPrimary != 0,
+		})
+	}
+
+	return profile
+}
+
+func (x profileGameXML) game() ProfileGame {
+	game := ProfileGame{
+		Name: x.GameName,
+		URL:  x.GameLink,
+		Icon: x.GameIcon,
+		Logo: x.GameLogo,
+	}
+
+	game.HoursPlayed, _ = strconv.ParseFloat(strings.Replace(x.HoursPlayed, ",", "", -1), 64)
+	game.HoursOnRecord, _ = strconv.ParseFloat(strings.Replace(x.HoursOnRecord, ",", "", -1), 64)
+
+	return game
+}
@@ -0,0 +1,132 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// profileResponse serves a public profile's XML.
+func profileResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+		<profile>
+			<steamID64>76561198063808035</steamID64>
+			<steamID><![CDATA[shival]]></steamID>
+			<onlineState>in-game</onlineState>
+			<stateMessage><![CDATA[In-Game<br/>Team Fortress 2]]></stateMessage>
+			<privacyState>public</privacyState>
+			<visibilityState>3</visibilityState>
+			<avatarIcon><![CDATA[https://example.com/avatar.jpg]]></avatarIcon>
+			<avatarMedium><![CDATA[https://example.com/avatar_medium.jpg]]></avatarMedium>
+			<avatarFull><![CDATA[https://example.com/avatar_full.jpg]]></avatarFull>
+			<vacBanned>0</vacBanned>
+			<tradeBanState>None</tradeBanState>
+			<isLimitedAccount>1</isLimitedAccount>
+			<customURL><![CDATA[shival]]></customURL>
+			<inGameServerIP>203.0.113.1:27015</inGameServerIP>
+			<inGameInfo>
+				<gameName><![CDATA[Team Fortress 2]]></gameName>
+				<gameLink><![CDATA[https://steamcommunity.com/app/440]]></gameLink>
+				<gameIcon><![CDATA[https://example.com/tf2_icon.jpg]]></gameIcon>
+				<gameLogo><![CDATA[https://example.com/tf2_logo.jpg]]></gameLogo>
+			</inGameInfo>
+			<memberSince>May 7, 2012</memberSince>
+			<headline><![CDATA[]]></headline>
+			<location><![CDATA[Melbourne, Victoria, Australia]]></location>
+			<realname><![CDATA[Alex]]></realname>
+			<summary><![CDATA[Hello &amp; welcome]]></summary>
+			<mostPlayedGames>
+				<mostPlayedGame>
+					<gameName><![CDATA[Team Fortress 2]]></gameName>
+					<gameLink><![CDATA[https://steamcommunity.com/app/440]]></gameLink>
+					<hoursPlayed>12.5</hoursPlayed>
+					<hoursOnRecord>1,234.5</hoursOnRecord>
+				</mostPlayedGame>
+			</mostPlayedGames>
+			<groups>
+				<group isPrimary="1">
+					<groupID64>103582791454641428</groupID64>
+					<groupName><![CDATA[shival]]></groupName>
+					<groupURL><![CDATA[shival]]></groupURL>
+				</group>
+				<group isPrimary="0">
+					<groupID64>103582791429521412</groupID64>
+				</group>
+			</groups>
+		</profile>
+	`))
+}
+
+func profilePageResponse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`<div class="persona_level"><span class="friendPlayerLevelNum">42</span></div>`))
+}
+
+func (s *ClientTestSuite) TestProfile() {
+	s.login(profileResponse, profilePageResponse)
+
+	profile, err := s.Client.Profile("76561198063808035")
+	assert.NoError(s.T(), err)
+
+	assert.Equal(s.T(), "76561198063808035", profile.SteamID)
+	assert.Equal(s.T(), "shival", profile.PersonaName)
+	assert.Equal(s.T(), "Alex", profile.RealName)
+	assert.Equal(s.T(), "shival", profile.CustomURL)
+	assert.Equal(s.T(), "https://example.com/avatar_full.jpg", profile.AvatarFull)
+	assert.Equal(s.T(), steamcommunity.ProfilePublic, profile.Privacy)
+	assert.Equal(s.T(), steamcommunity.ProfileInGame, profile.OnlineState)
+	assert.Equal(s.T(), "Team Fortress 2", profile.InGame.Name)
+	assert.Equal(s.T(), "203.0.113.1:27015", profile.InGameServerIP)
+	assert.Equal(s.T(), time.Date(2012, time.May, 7, 0, 0, 0, 0, time.UTC), profile.MemberSince)
+	assert.False(s.T(), profile.VACBanned)
+	assert.Equal(s.T(), "None", profile.TradeBanState)
+	assert.True(s.T(), profile.LimitedAccount)
+	assert.Equal(s.T(), "Melbourne, Victoria, Australia", profile.Location)
+	assert.Equal(s.T(), 42, profile.Level)
+
+	assert.Len(s.T(), profile.MostPlayedGames, 1)
+	assert.Equal(s.T(), 12.5, profile.MostPlayedGames[0].HoursPlayed)
+	assert.Equal(s.T(), 1234.5, profile.MostPlayedGames[0].HoursOnRecord)
+
+	assert.Equal(s.T(), []steamcommunity.UserGroup{
+		{ID: "103582791454641428", Name: "shival", URL: "shival", Primary: true},
+		{ID: "103582791429521412"},
+	}, profile.Groups)
+}
+
+func (s *ClientTestSuite) TestProfilePrivate() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+			<profile>
+				<steamID64>76561198063808035</steamID64>
+				<steamID><![CDATA[shival]]></steamID>
+				<onlineState>offline</onlineState>
+				<privacyState>private</privacyState>
+				<visibilityState>1</visibilityState>
+			</profile>
+		`))
+	})
+
+	profile, err := s.Client.Profile("76561198063808035")
+	assert.Equal(s.T(), steamcommunity.ErrorProfilePrivate, err)
+	assert.Equal(s.T(), "shival", profile.PersonaName)
+	assert.Equal(s.T(), steamcommunity.ProfilePrivate, profile.Privacy)
+}
+
+func (s *ClientTestSuite) TestProfileNotFound() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+			<response><error><![CDATA[The specified profile could not be found.]]></error></response>
+		`))
+	})
+
+	profile, err := s.Client.Profile("76561197960265728")
+	assert.Equal(s.T(), steamcommunity.ErrorProfileNotFound, err)
+	assert.Nil(s.T(), profile)
+}
@@ -0,0 +1,315 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+var profileEditDataRegexp = regexp.MustCompile(`data-profile-edit="([^"]*)"`)
+
+// PrivacyLevel is who can see part of a user's profile.
+type PrivacyLevel int
+
+const (
+	PrivacyPrivate     PrivacyLevel = 1
+	PrivacyFriendsOnly PrivacyLevel = 2
+	PrivacyPublic      PrivacyLevel = 3
+)
+
+// CommentPermission is who can comment on a user's profile.
+type CommentPermission int
+
+const (
+	CommentsEveryone CommentPermission = iota + 1
+	CommentsFriendsOnly
+	CommentsPrivate
+)
+
+// commentPermissions maps each CommentPermission to the value Steam uses for it.
+var commentPermissions = map[CommentPermission]int{
+	CommentsEveryone:    1,
+	CommentsFriendsOnly: 0,
+	CommentsPrivate:     2,
+}
+
+// ProfileSettings are the editable details of the logged in user's profile.
+type ProfileSettings struct {
+	PersonaName string
+	RealName    string
+	Summary     string
+	// Country, State and City are Steam location codes, such as "AU", "07" and "4180".
+	Country   string
+	State     string
+	City      string
+	CustomURL string
+}
+
+// ProfileUpdate is a change to the logged in user's profile.
+// Nil fields are left unchanged, and fields set to an empty string are cleared.
+type ProfileUpdate struct {
+	PersonaName *string
+	RealName    *string
+	Summary     *string
+	// Country, State and City are Steam location codes, such as "AU", "07" and "4180".
+	Country   *string
+	State     *string
+	City      *string
+	CustomURL *string
+}
+
+// PrivacySettings are the privacy settings of the logged in user's profile.
+// When updating, zero valued fields are left unchanged.
+type PrivacySettings struct {
+	Profile     PrivacyLevel
+	Inventory   PrivacyLevel
+	GameDetails PrivacyLevel
+	FriendsList PrivacyLevel
+	Comments    CommentPermission
+}
+
+// ProfileAvatar is the URLs of a user's avatar in each size.
+type ProfileAvatar struct {
+	Icon   string
+	Medium string
+	Full   string
+}
+
+type profileEditData struct {
+	PersonaName  string `json:"strPersonaName"`
+	CustomURL    string `json:"strCustomURL"`
+	RealName     string `json:"strRealName"`
+	Summary      string `json:"strSummary"`
+	LocationData struct {
+		Country string `json:"locCountryCode"`
+		State   string `json:"locStateCode"`
+		City    string `json:"locCityCode"`
+	} `json:"LocationData"`
+	Privacy privacyData `json:"Privacy"`
+}
+
+type privacyData struct {
+	// PrivacySettings is kept as a map so settings we don't expose are sent back unchanged.
+	PrivacySettings   map[string]int `json:"PrivacySettings"`
+	CommentPermission int            `json:"eCommentPermission"`
+}
+
+type profileSaveResponse struct {
+	// Success is an EResult, where 1 is OK.
+	Success int    `json:"success"`
+	Error   string `json:"errmsg"`
+}
+
+type setPrivacyResponse struct {
+	Success steamBool   `json:"success"`
+	Privacy privacyData `json:"Privacy"`
+}
+
+// ProfileSettings retrieves the editable details of the logged in user's profile.
+func (c *Client) ProfileSettings() (*ProfileSettings, error) {
+	data, err := c.profileEditData()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data.settings(), nil
+}
+
+// EditProfile updates the logged in user's profile, returning the details as Steam reports them afterwards.
+func (c *Client) EditProfile(update ProfileUpdate) (*ProfileSettings, error) {
+	data, err := c.profileEditData()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Steam clears fields that are not sent, so start from the current values.
+	settings := data.settings()
+
+	for _, field := range []struct {
+		value  *string
+		target *string
+	}{
+		{update.PersonaName, &settings.PersonaName},
+		{update.RealName, &settings.RealName},
+		{update.Summary, &settings.Summary},
+		{update.Country, &settings.Country},
+		{update.State, &settings.State},
+		{update.City, &settings.City},
+		{update.CustomURL, &settings.CustomURL},
+	} {
+		if field.value != nil {
+			*field.target = *field.value
+		}
+	}
+
+	var saveResp profileSaveResponse
+	err = c.postFormJSON(fmt.Sprintf("https://steamcommunity.com/profiles/%s/edit/", c.SteamID), map[string]string{
+		"sessionID":   c.SessionID,
+		"type":        "profileSave",
+		"json":        "1",
+		"personaName": settings.PersonaName,
+		"real_name":   settings.RealName,
+		"summary":     settings.Summary,
+		"country":     settings.Country,
+		"state":       settings.State,
+		"city":        settings.City,
+		"customURL":   settings.CustomURL,
+	}, &saveResp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if saveResp.Success != 1 {
+		if saveResp.Error != "" {
+			return nil, fmt.Errorf("steamcommunity: %s", saveResp.Error)
+		}
+
+		return nil, errors.New("steamcommunity: Failed to save profile")
+	}
+
+	return c.ProfileSettings()
+}
+
+// PrivacySettings retrieves the privacy settings of the logged in user's profile.
+func (c *Client) PrivacySettings() (*PrivacySettings, error) {
+	data, err := c.profileEditData()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Privacy.settings(), nil
+}
+
+// SetPrivacy updates the privacy settings of the logged in user's profile, returning the settings as Steam reports them afterwards.
+func (c *Client) SetPrivacy(update PrivacySettings) (*PrivacySettings, error) {
+	data, err := c.profileEditData()
+
+	if err != nil {
+		return nil, err
+	}
+
+	privacy := data.Privacy.PrivacySettings
+	if privacy == nil {
+		privacy = make(map[string]int)
+	}
+
+	for key, level := range map[string]PrivacyLevel{
+		"PrivacyProfile":     update.Profile,
+		"PrivacyInventory":   update.Inventory,
+		"PrivacyOwnedGames":  update.GameDetails,
+		"PrivacyFriendsList": update.FriendsList,
+	} {
+		if level != 0 {
+			privacy[key] = int(level)
+		}
+	}
+
+	commentPermission := data.Privacy.CommentPermission
+	if update.Comments != 0 {
+		commentPermission = commentPermissions[update.Comments]
+	}
+
+	privacyJSON, err := json.Marshal(privacy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var privacyResp setPrivacyResponse
+	err = c.postValuesJSON(fmt.Sprintf("https://steamcommunity.com/profiles/%s/ajaxsetprivacy/", c.SteamID), url.Values{
+		"sessionid":          {c.SessionID},
+		"Privacy":            {string(privacyJSON)},
+		"eCommentPermission": {strconv.Itoa(commentPermission)},
+	}, &privacyResp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !privacyResp.Success {
+		return nil, errors.New("steamcommunity: Failed to set privacy")
+	}
+
+	return privacyResp.Privacy.settings(), nil
+}
+
+// UploadAvatar replaces the logged in user's avatar with the image read from r, returning the new avatar's URLs.
+func (c *Client) UploadAvatar(r io.Reader) (*ProfileAvatar, error) {
+	uploadResp, err := c.uploadAvatar(map[string]string{
+		"type": "player_avatar_image",
+		"sId":  c.SteamID,
+	}, r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfileAvatar{
+		Icon:   uploadResp.Images["0"],
+		Medium: uploadResp.Images["medium"],
+		Full:   uploadResp.Images["full"],
+	}, nil
+}
+
+// profileEditData retrieves the current profile details embedded in the profile edit page.
+func (c *Client) profileEditData() (*profileEditData, error) {
+	page, err := c.getPage(fmt.Sprintf("https://steamcommunity.com/profiles/%s/edit/info", c.SteamID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	match := profileEditDataRegexp.FindStringSubmatch(page)
+
+	if match == nil {
+		return nil, errors.New("steamcommunity: Profile details missing from edit page")
+	}
+
+	var data profileEditData
+	if err = json.Unmarshal([]byte(html.UnescapeString(match[1])), &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+func (d *profileEditData) settings() *ProfileSettings {
+	return &ProfileSettings{
+		PersonaName: d.PersonaName,
+		RealName:    d.RealName,
+		Summary:     d.Summary,
+		Country:     d.LocationData.Country,
+		State:       d.LocationData.State,
+		City:        d.LocationData.City,
+		CustomURL:   d.CustomURL,
+	}
+}
+
+func (d *privacyData) settings() *PrivacySettings {
+	return &PrivacySettings{
+		Profile:     PrivacyLevel(d.PrivacySettings["PrivacyProfile"]),
+		Inventory:   PrivacyLevel(d.PrivacySettings["PrivacyInventory"]),
+		GameDetails: PrivacyLevel(d.PrivacySettings["PrivacyOwnedGames"]),
+		FriendsList: PrivacyLevel(d.PrivacySettings["PrivacyFriendsList"]),
+		Comments:    d.commentPermission(),
+	}
+}
+
+func (d *privacyData) commentPermission() CommentPermission {
+	for permission, value := range commentPermissions {
+		if value == d.CommentPermission {
+			return permission
+		}
+	}
+
+	return 0
+}
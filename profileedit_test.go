@@ -0,0 +1,135 @@
+package steamcommunity_test
+
+import (
+	"encoding/json"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// profileEditResponse serves the profile edit page with the given persona name.
+func profileEditResponse(personaName string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		data := `{"strPersonaName":"` + personaName + `","strCustomURL":"shival","strRealName":"Alex","strSummary":"Hi",` +
+			`"LocationData":{"locCountryCode":"AU","locStateCode":"07","locCityCode":"4180"},` +
+			`"Privacy":{"PrivacySettings":{"PrivacyProfile":3,"PrivacyInventory":2,"PrivacyInventoryGifts":1,"PrivacyOwnedGames":3,"PrivacyPlaytime":3,"PrivacyFriendsList":3},"eCommentPermission":1}}`
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<div id="profile_edit_config" data-profile-edit="` + html.EscapeString(data) + `"></div>`))
+	}
+}
+
+func (s *ClientTestSuite) TestEditProfile() {
+	var saved string
+
+	s.login(
+		profileEditResponse("shival"),
+
+		// Save request.
+		func(w http.ResponseWriter, r *http.Request) {
+			saved = s.LastRequestBody
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"errmsg":""}`))
+		},
+
+		profileEditResponse("shival bot"),
+	)
+
+	personaName, summary := "shival bot", ""
+	settings, err := s.Client.EditProfile(steamcommunity.ProfileUpdate{
+		PersonaName: &personaName,
+		Summary:     &summary,
+	})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &steamcommunity.ProfileSettings{
+		PersonaName: "shival bot",
+		RealName:    "Alex",
+		Summary:     "Hi",
+		Country:     "AU",
+		State:       "07",
+		City:        "4180",
+		CustomURL:   "shival",
+	}, settings)
+
+	form, err := url.ParseQuery(saved)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "shival bot", form.Get("personaName"))
+	assert.Equal(s.T(), "Alex", form.Get("real_name"))
+	assert.Equal(s.T(), "profileSave", form.Get("type"))
+
+	// Summary was cleared, rather than left unchanged.
+	assert.Contains(s.T(), form, "summary")
+	assert.Empty(s.T(), form.Get("summary"))
+}
+
+func (s *ClientTestSuite) TestEditProfileError() {
+	s.login(
+		profileEditResponse("shival"),
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":2,"errmsg":"The profile URL you specified is already in use."}`))
+		},
+	)
+
+	customURL := "gaben"
+	_, err := s.Client.EditProfile(steamcommunity.ProfileUpdate{CustomURL: &customURL})
+	assert.EqualError(s.T(), err, "steamcommunity: The profile URL you specified is already in use.")
+}
+
+func (s *ClientTestSuite) TestSetPrivacy() {
+	s.login(
+		profileEditResponse("shival"),
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1,"Privacy":{"PrivacySettings":{"PrivacyProfile":3,"PrivacyInventory":1,"PrivacyInventoryGifts":1,"PrivacyOwnedGames":3,"PrivacyPlaytime":3,"PrivacyFriendsList":3},"eCommentPermission":0}}`))
+		},
+	)
+
+	settings, err := s.Client.SetPrivacy(steamcommunity.PrivacySettings{
+		Inventory: steamcommunity.PrivacyPrivate,
+		Comments:  steamcommunity.CommentsFriendsOnly,
+	})
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &steamcommunity.PrivacySettings{
+		Profile:     steamcommunity.PrivacyPublic,
+		Inventory:   steamcommunity.PrivacyPrivate,
+		GameDetails: steamcommunity.PrivacyPublic,
+		FriendsList: steamcommunity.PrivacyPublic,
+		Comments:    steamcommunity.CommentsFriendsOnly,
+	}, settings)
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "0", form.Get("eCommentPermission"))
+
+	var privacy map[string]int
+	assert.NoError(s.T(), json.Unmarshal([]byte(form.Get("Privacy")), &privacy))
+	assert.Equal(s.T(), map[string]int{
+		"PrivacyProfile":        3,
+		"PrivacyInventory":      1,
+		"PrivacyInventoryGifts": 1,
+		"PrivacyOwnedGames":     3,
+		"PrivacyPlaytime":       3,
+		"PrivacyFriendsList":    3,
+	}, privacy)
+}
+
+func (s *ClientTestSuite) TestUploadAvatar() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"images":{"0":"https://example.com/a.jpg","medium":"https://example.com/a_medium.jpg","full":"https://example.com/a_full.jpg"},"hash":"a"}`))
+	})
+
+	avatar, err := s.Client.UploadAvatar(strings.NewReader("not really a jpeg"))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &steamcommunity.ProfileAvatar{
+		Icon:   "https://example.com/a.jpg",
+		Medium: "https://example.com/a_medium.jpg",
+		Full:   "https://example.com/a_full.jpg",
+	}, avatar)
+	assert.Contains(s.T(), s.LastRequestBody, "player_avatar_image")
+}
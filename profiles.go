@@ -0,0 +1,425 @@
+package steamcommunity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultProfilesConcurrency is the number of profiles requested at once when ProfilesOptions.Concurrency is not set.
+	DefaultProfilesConcurrency = 4
+	// DefaultProfilesInterval is the minimum time between requests when ProfilesOptions.Interval is not set.
+	DefaultProfilesInterval = 250 * time.Millisecond
+	// DefaultProfilesRetries is the number of times a failed request is retried when ProfilesOptions.Retries is not set.
+	DefaultProfilesRetries = 2
+	// DefaultProfileCacheTTL is how long profiles are cached when ProfilesOptions.CacheTTL is not set.
+	DefaultProfileCacheTTL = 5 * time.Minute
+
+	// playerSummariesBatchSize is the most SteamIDs GetPlayerSummaries accepts per request.
+	playerSummariesBatchSize = 100
+)
+
+// ProfilesOptions configures how Profiles retrieves profiles.
+type ProfilesOptions struct {
+	// Concurrency is the maximum number of requests in flight at once.
+	Concurrency int
+
+	// Interval is the minimum time between requests across every worker, to stay under Steam's rate limits.
+	// A negative Interval disables the limit.
+	Interval time.Duration
+
+	// Retries is the number of times a failed request is retried, waiting Interval longer before each attempt.
+	// Private and missing profiles are not retried. A negative Retries disables retrying.
+	Retries int
+
+	// CacheTTL is how long retrieved profiles are reused by later calls to Profiles. A negative CacheTTL disables the cache.
+	CacheTTL time.Duration
+
+	// UseWebAPI retrieves profiles 100 at a time with ISteamUser/GetPlayerSummaries when Client.APIKey is set.
+	// Profiles from the Web API only contain the persona name, real name, avatars, privacy, online state,
+	// game, member since date and country code.
+	UseWebAPI bool
+}
+
+// ProfileResult is the result of retrieving a single profile with Profiles.
+type ProfileResult struct {
+	SteamID string
+	// Profile is set when Err is nil or ErrorProfilePrivate.
+	Profile *Profile
+	Err     error
+}
+
+type profileCache struct {
+	mu      sync.Mutex
+	entries map[string]profileCacheEntry
+}
+
+type profileCacheEntry struct {
+	profile *Profile
+	err     error
+	expires time.Time
+}
+
+type playerSummariesResponse struct {
+	Response struct {
+		Players []playerSummary `json:"players"`
+	} `json:"response"`
+}
+
+type playerSummary struct {
+	SteamID                  string `json:"steamid"`
+	CommunityVisibilityState int    `json:"communityvisibilitystate"`
+	PersonaName              string `json:"personaname"`
+	ProfileURL               string `json:"profileurl"`
+	Avatar                   string `json:"avatar"`
+	AvatarMedium             string `json:"avatarmedium"`
+	AvatarFull               string `json:"avatarfull"`
+	PersonaState             int    `json:"personastate"`
+	RealName                 string `json:"realname"`
+	TimeCreated              int64  `json:"timecreated"`
+	GameID                   string `json:"gameid"`
+	GameExtraInfo            string `json:"gameextrainfo"`
+	GameServerIP             string `json:"gameserverip"`
+	LocCountryCode           string `json:"loccountrycode"`
+}
+
+// Profiles retrieves the profiles of many users concurrently, sending a result for each SteamID64 on the returned channel.
+// Results are sent in the order they are retrieved, and duplicate SteamIDs are only retrieved once.
+// The channel is closed once every profile has been retrieved, or ctx is done.
+// opts may be nil to use the defaults.
+func (c *Client) Profiles(ctx context.Context, steamIDs []string, opts *ProfilesOptions) <-chan ProfileResult {
+	var o ProfilesOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultProfilesConcurrency
+	}
+
+	if o.Interval == 0 {
+		o.Interval = DefaultProfilesInterval
+	}
+
+	if o.Retries == 0 {
+		o.Retries = DefaultProfilesRetries
+	}
+
+	if o.CacheTTL == 0 {
+		o.CacheTTL = DefaultProfileCacheTTL
+	}
+
+	useWebAPI := o.UseWebAPI && c.APIKey != ""
+	batchSize := 1
+	if useWebAPI {
+		batchSize = playerSummariesBatchSize
+	}
+
+	results := make(chan ProfileResult)
+
+	go func() {
+		defer close(results)
+
+		send := func(result ProfileResult) bool {
+			select {
+			case results <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// Serve what we can from the cache, and batch up the rest.
+		c.profiles.prune()
+
+		var batches [][]string
+		var batch []string
+		seen := make(map[string]bool, len(steamIDs))
+		for _, id := range steamIDs {
+			if seen[id] {
+				continue
+			}
+
+			seen[id] = true
+
+			if o.CacheTTL > 0 {
+				if profile, err, ok := c.profiles.get(id, useWebAPI); ok {
+					if !send(ProfileResult{SteamID: id, Profile: profile, Err: err}) {
+						return
+					}
+
+					continue
+				}
+			}
+
+			batch = append(batch, id)
+			if len(batch) == batchSize {
+				batches = append(batches, batch)
+				batch = nil
+			}
+		}
+
+		if len(batch) > 0 {
+			batches = append(batches, batch)
+		}
+
+		var throttle <-chan time.Time
+		if o.Interval > 0 {
+			ticker := time.NewTicker(o.Interval)
+			defer ticker.Stop()
+			throttle = ticker.C
+		}
+
+		jobs := make(chan []string)
+		var wg sync.WaitGroup
+
+		for i := 0; i < o.Concurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for ids := range jobs {
+					for _, result := range c.fetchProfiles(ctx, ids, useWebAPI, o.Retries, throttle) {
+						if o.CacheTTL > 0 && (result.Err == nil || result.Err == ErrorProfilePrivate || result.Err == ErrorProfileNotFound) {
+							c.profiles.put(result.SteamID, useWebAPI, result.Profile, result.Err, o.CacheTTL)
+						}
+
+						if !send(result) {
+							return
+						}
+					}
+				}
+			}()
+		}
+
+	feed:
+		for _, ids := range batches {
+			select {
+			case jobs <- ids:
+			case <-ctx.Done():
+				break feed
+			}
+		}
+
+		close(jobs)
+		wg.Wait()
+	}()
+
+	return results
+}
+
+// fetchProfiles retrieves a batch of profiles, retrying failed requests.
+// Every request waits for throttle, as a profile without the Web API takes two requests.
+// Private and missing profiles are results rather than failures, so they are not retried.
+func (c *Client) fetchProfiles(ctx context.Context, ids []string, useWebAPI bool, retries int, throttle <-chan time.Time) []ProfileResult {
+	wait := func() error {
+		if throttle == nil {
+			return nil
+		}
+
+		select {
+		case <-throttle:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		// Back off further on each retry.
+		for i := 0; i < attempt; i++ {
+			if wait() != nil {
+				return nil
+			}
+		}
+
+		var results []ProfileResult
+		var err error
+
+		if useWebAPI {
+			if wait() != nil {
+				return nil
+			}
+
+			results, err = c.playerSummaries(ctx, ids)
+		} else {
+			var profile *Profile
+			profile, err = c.profile(ctx, ids[0], wait)
+			results = []ProfileResult{{SteamID: ids[0], Profile: profile, Err: err}}
+
+			if err == ErrorProfilePrivate || err == ErrorProfileNotFound {
+				err = nil
+			}
+		}
+
+		if err == nil {
+			return results
+		}
+
+		if attempt >= retries || ctx.Err() != nil {
+			results = make([]ProfileResult, len(ids))
+			for i, id := range ids {
+				results[i] = ProfileResult{SteamID: id, Err: err}
+			}
+
+			return results
+		}
+	}
+}
+
+// playerSummaries retrieves up to 100 profiles from the Web API.
+func (c *Client) playerSummaries(ctx context.Context, ids []string) ([]ProfileResult, error) {
+	resp, err := c.getContext(ctx, fmt.Sprintf(
+		"https://api.steampowered.com/ISteamUser/GetPlayerSummaries/v2/?key=%s&steamids=%s",
+		c.APIKey,
+		strings.Join(ids, ","),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	var summariesResp playerSummariesResponse
+	if err = json.Unmarshal(body, &summariesResp); err != nil {
+		return nil, err
+	}
+
+	players := make(map[string]*playerSummary, len(summariesResp.Response.Players))
+	for i := range summariesResp.Response.Players {
+		player := &summariesResp.Response.Players[i]
+		players[player.SteamID] = player
+	}
+
+	results := make([]ProfileResult, len(ids))
+	for i, id := range ids {
+		results[i].SteamID = id
+
+		player, ok := players[id]
+		if !ok {
+			results[i].Err = ErrorProfileNotFound
+			continue
+		}
+
+		results[i].Profile = player.profile()
+
+		// Private and friends only profiles are both reported as not visible.
+		if player.CommunityVisibilityState != 3 {
+			results[i].Err = ErrorProfilePrivate
+		}
+	}
+
+	return results, nil
+}
+
+// profile converts a player summary to a Profile.
+func (p *playerSummary) profile() *Profile {
+	profile := &Profile{
+		SteamID:        p.SteamID,
+		PersonaName:    p.PersonaName,
+		RealName:       p.RealName,
+		AvatarIcon:     p.Avatar,
+		AvatarMedium:   p.AvatarMedium,
+		AvatarFull:     p.AvatarFull,
+		Privacy:        ProfilePublic,
+		OnlineState:    ProfileOffline,
+		InGameServerIP: p.GameServerIP,
+		CountryCode:    p.LocCountryCode,
+	}
+
+	if p.CommunityVisibilityState != 3 {
+		profile.Privacy = ProfilePrivate
+	}
+
+	if strings.Contains(p.ProfileURL, "/id/") {
+		profile.CustomURL = strings.Trim(p.ProfileURL[strings.Index(p.ProfileURL, "/id/")+4:], "/")
+	}
+
+	if p.TimeCreated > 0 {
+		profile.MemberSince = time.Unix(p.TimeCreated, 0)
+	}
+
+	if p.GameExtraInfo != "" {
+		profile.OnlineState = ProfileInGame
+		profile.InGame = &ProfileGame{Name: p.GameExtraInfo, URL: fmt.Sprintf("https://steamcommunity.com/app/%s", p.GameID)}
+	} else if p.PersonaState != 0 {
+		profile.OnlineState = ProfileOnline
+	}
+
+	return profile
+}
+
+// get returns a cached profile. Profiles from the Web API are cached separately, as they contain fewer fields.
+func (pc *profileCache) get(steamID string, webAPI bool) (*Profile, error, bool) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	entry, ok := pc.entries[profileCacheKey(steamID, webAPI)]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, nil, false
+	}
+
+	return entry.profile.copy(), entry.err, true
+}
+
+func (pc *profileCache) put(steamID string, webAPI bool, profile *Profile, err error, ttl time.Duration) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if pc.entries == nil {
+		pc.entries = make(map[string]profileCacheEntry)
+	}
+
+	pc.entries[profileCacheKey(steamID, webAPI)] = profileCacheEntry{profile: profile.copy(), err: err, expires: time.Now().Add(ttl)}
+}
+
+// copy returns a copy of the profile, so cached profiles can't be changed through the profiles handed to callers.
+func (p *Profile) copy() *Profile {
+	if p == nil {
+		return nil
+	}
+
+	profile := *p
+
+	if p.InGame != nil {
+		game := *p.InGame
+		profile.InGame = &game
+	}
+
+	profile.Groups = append([]UserGroup(nil), p.Groups...)
+	profile.MostPlayedGames = append([]ProfileGame(nil), p.MostPlayedGames...)
+
+	return &profile
+}
+
+// prune drops expired entries, so the cache only holds recent results.
+func (pc *profileCache) prune() {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range pc.entries {
+		if now.After(entry.expires) {
+			delete(pc.entries, key)
+		}
+	}
+}
+
+func profileCacheKey(steamID string, webAPI bool) string {
+	if webAPI {
+		return "api:" + steamID
+	}
+
+	return steamID
+}
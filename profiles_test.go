@@ -0,0 +1,156 @@
+package steamcommunity_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// profilesResponse serves profile XML for whichever SteamID is requested, and an empty profile page.
+// 76561197960265728 is not found.
+func profilesResponse(w http.ResponseWriter, r *http.Request) {
+	id := strings.Split(strings.Trim(r.URL.Path, "/"), "/")[1]
+
+	w.WriteHeader(http.StatusOK)
+
+	if r.URL.Query().Get("xml") != "1" {
+		return
+	}
+
+	if id == "76561197960265728" {
+		w.Write([]byte(`<response><error><![CDATA[The specified profile could not be found.]]></error></response>`))
+		return
+	}
+
+	fmt.Fprintf(w, `<profile>
+		<steamID64>%s</steamID64>
+		<steamID><![CDATA[user %s]]></steamID>
+		<privacyState>public</privacyState>
+		<memberSince>May 7, 2012</memberSince>
+	</profile>`, id, id)
+}
+
+func collectProfiles(results <-chan steamcommunity.ProfileResult) map[string]steamcommunity.ProfileResult {
+	collected := make(map[string]steamcommunity.ProfileResult)
+	for result := range results {
+		collected[result.SteamID] = result
+	}
+
+	return collected
+}
+
+func (s *ClientTestSuite) TestProfiles() {
+	s.login(profilesResponse)
+
+	ids := []string{"76561198063808035", "76561198063808036", "76561197960265728", "76561198063808035"}
+	results := collectProfiles(s.Client.Profiles(context.Background(), ids, &steamcommunity.ProfilesOptions{Interval: -1}))
+
+	assert.Len(s.T(), results, 3)
+	assert.NoError(s.T(), results["76561198063808035"].Err)
+	assert.Equal(s.T(), "user 76561198063808035", results["76561198063808035"].Profile.PersonaName)
+	assert.Equal(s.T(), "user 76561198063808036", results["76561198063808036"].Profile.PersonaName)
+	assert.Equal(s.T(), steamcommunity.ErrorProfileNotFound, results["76561197960265728"].Err)
+
+	// Later calls are served from the cache.
+	s.ResponseFunc = []func(http.ResponseWriter, *http.Request){
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	}
+	s.CurrentResponseFunc = 0
+
+	// Changing a returned profile doesn't change the cached one.
+	results["76561198063808035"].Profile.PersonaName = "changed"
+
+	results = collectProfiles(s.Client.Profiles(context.Background(), ids[:1], &steamcommunity.ProfilesOptions{Interval: -1}))
+	assert.NoError(s.T(), results["76561198063808035"].Err)
+	assert.Equal(s.T(), "user 76561198063808035", results["76561198063808035"].Profile.PersonaName)
+
+	results["76561198063808035"].Profile.PersonaName = "changed"
+
+	results = collectProfiles(s.Client.Profiles(context.Background(), ids[:1], &steamcommunity.ProfilesOptions{Interval: -1}))
+	assert.Equal(s.T(), "user 76561198063808035", results["76561198063808035"].Profile.PersonaName)
+
+	// Without the cache, the failure is retried then reported.
+	results = collectProfiles(s.Client.Profiles(context.Background(), ids[:1], &steamcommunity.ProfilesOptions{
+		Interval: -1,
+		CacheTTL: -1,
+	}))
+	assert.Equal(s.T(), steamcommunity.ErrorUnknown, results["76561198063808035"].Err)
+}
+
+func (s *ClientTestSuite) TestProfilesRetry() {
+	s.login(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		},
+		profilesResponse,
+	)
+
+	results := collectProfiles(s.Client.Profiles(context.Background(), []string{"76561198063808037"}, &steamcommunity.ProfilesOptions{
+		Interval: -1,
+		CacheTTL: -1,
+	}))
+
+	assert.NoError(s.T(), results["76561198063808037"].Err)
+	assert.Equal(s.T(), "user 76561198063808037", results["76561198063808037"].Profile.PersonaName)
+}
+
+func (s *ClientTestSuite) TestProfilesInterval() {
+	s.login(profilesResponse)
+
+	// Each profile takes two requests, the profile XML and the profile page, and each waits for the interval.
+	interval := 50 * time.Millisecond
+	start := time.Now()
+	results := collectProfiles(s.Client.Profiles(context.Background(), []string{"76561198063808035", "76561198063808036"}, &steamcommunity.ProfilesOptions{
+		Interval: interval,
+		CacheTTL: -1,
+	}))
+
+	assert.Len(s.T(), results, 2)
+	assert.True(s.T(), time.Since(start) >= 4*interval-interval/2)
+}
+
+func (s *ClientTestSuite) TestProfilesWebAPI() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("steamids"), ",")
+		sort.Strings(ids)
+
+		assert.Equal(s.T(), "/ISteamUser/GetPlayerSummaries/v2/", r.URL.Path)
+		assert.Equal(s.T(), "key", r.URL.Query().Get("key"))
+		assert.Equal(s.T(), []string{"76561197960265728", "76561198063808035", "76561198063808036"}, ids)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response":{"players":[
+			{"steamid":"76561198063808035","communityvisibilitystate":3,"personaname":"shival","profileurl":"https://steamcommunity.com/id/shival/","personastate":1,"gameid":"440","gameextrainfo":"Team Fortress 2","loccountrycode":"AU"},
+			{"steamid":"76561198063808036","communityvisibilitystate":1,"personaname":"private","profileurl":"https://steamcommunity.com/profiles/76561198063808036/","personastate":0}
+		]}}`))
+	})
+
+	s.Client.APIKey = "key"
+
+	ids := []string{"76561198063808035", "76561198063808036", "76561197960265728"}
+	results := collectProfiles(s.Client.Profiles(context.Background(), ids, &steamcommunity.ProfilesOptions{UseWebAPI: true}))
+
+	assert.Len(s.T(), results, 3)
+
+	profile := results["76561198063808035"].Profile
+	assert.NoError(s.T(), results["76561198063808035"].Err)
+	assert.Equal(s.T(), "shival", profile.PersonaName)
+	assert.Equal(s.T(), "shival", profile.CustomURL)
+	assert.Equal(s.T(), steamcommunity.ProfileInGame, profile.OnlineState)
+	assert.Equal(s.T(), "Team Fortress 2", profile.InGame.Name)
+	assert.Equal(s.T(), "AU", profile.CountryCode)
+	assert.Empty(s.T(), profile.Location)
+
+	assert.Equal(s.T(), steamcommunity.ErrorProfilePrivate, results["76561198063808036"].Err)
+	assert.Equal(s.T(), steamcommunity.ProfilePrivate, results["76561198063808036"].Profile.Privacy)
+	assert.Equal(s.T(), steamcommunity.ErrorProfileNotFound, results["76561197960265728"].Err)
+}
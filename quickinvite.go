@@ -0,0 +1,238 @@
+package steamcommunity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// quickInviteAlphabet replaces the hex digits of an account ID in a quick invite short code.
+const quickInviteAlphabet = "bcdfghjkmnpqrtvw"
+
+var quickInviteLinkRegexp = regexp.MustCompile(`^(?:https?://)?s\.team/p/([` + quickInviteAlphabet + `-]+)/([A-Za-z0-9]+)/?$`)
+
+var ErrorInvalidQuickInviteLink = errors.New("steamcommunity: Invalid quick invite link")
+
+// QuickInviteLink is an s.team link that adds the logged in user as a friend when redeemed.
+type QuickInviteLink struct {
+	URL   string
+	Token string
+	// Limit is the number of times the link can be redeemed, or 0 if it is unlimited.
+	Limit int
+	// Duration is how long after Created the link expires, or 0 if it does not expire.
+	Duration time.Duration
+	Created  time.Time
+	Valid    bool
+}
+
+type quickInviteToken struct {
+	InviteToken    string `json:"invite_token"`
+	InviteLimit    string `json:"invite_limit"`
+	InviteDuration string `json:"invite_duration"`
+	TimeCreated    int64  `json:"time_created"`
+	Valid          bool   `json:"valid"`
+}
+
+type createQuickInviteResponse struct {
+	Response quickInviteToken `json:"response"`
+}
+
+type listQuickInvitesResponse struct {
+	Response struct {
+		Tokens []quickInviteToken `json:"tokens"`
+	} `json:"response"`
+}
+
+// CreateQuickInviteLink creates a quick invite link for the logged in user.
+// A limit of 0 allows unlimited redemptions, and a duration of 0 never expires.
+func (c *Client) CreateQuickInviteLink(limit int, duration time.Duration) (*QuickInviteLink, error) {
+	form := map[string]string{}
+
+	if limit > 0 {
+		form["invite_limit"] = strconv.Itoa(limit)
+	}
+
+	if duration > 0 {
+		form["invite_duration"] = strconv.Itoa(int(duration / time.Second))
+	}
+
+	var createResp createQuickInviteResponse
+	if err := c.userAccountService("CreateFriendInviteToken", form, &createResp); err != nil {
+		return nil, err
+	}
+
+	if createResp.Response.InviteToken == "" {
+		return nil, errors.New("steamcommunity: Quick invite token missing from response")
+	}
+
+	return c.quickInviteLink(createResp.Response)
+}
+
+// ListQuickInviteLinks retrieves the logged in user's quick invite links.
+func (c *Client) ListQuickInviteLinks() ([]*QuickInviteLink, error) {
+	var listResp listQuickInvitesResponse
+	if err := c.userAccountService("GetFriendInviteTokens", map[string]string{}, &listResp); err != nil {
+		return nil, err
+	}
+
+	var links []*QuickInviteLink
+	for _, token := range listResp.Response.Tokens {
+		link, err := c.quickInviteLink(token)
+
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// RevokeQuickInviteLink revokes one of the logged in user's quick invite links. link may be the URL or its token.
+func (c *Client) RevokeQuickInviteLink(link string) error {
+	token := link
+	if _, t, err := parseQuickInviteLink(link); err == nil {
+		token = t
+	}
+
+	return c.userAccountService("RevokeFriendInviteToken", map[string]string{"invite_token": token}, nil)
+}
+
+// RedeemQuickInviteLink redeems another user's quick invite link, adding them as a friend.
+func (c *Client) RedeemQuickInviteLink(link string) error {
+	steamID, token, err := parseQuickInviteLink(link)
+
+	if err != nil {
+		return err
+	}
+
+	return c.userAccountService("RedeemFriendInviteToken", map[string]string{
+		"steamid":      steamID,
+		"invite_token": token,
+	}, nil)
+}
+
+// userAccountService calls a method of the IUserAccountService Web API as the logged in user, unmarshalling the response into v if it is not nil.
+func (c *Client) userAccountService(method string, form map[string]string, v interface{}) error {
+	form["access_token"] = c.OAuthToken
+
+	resp, err := c.postForm(
+		fmt.Sprintf("https://api.steampowered.com/IUserAccountService/%s/v1/", method),
+		map[string]string{},
+		form,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return err
+	}
+
+	// The Web API reports failures with the x-eresult header, where 1 is OK.
+	if eresult := resp.Header.Get("X-Eresult"); eresult != "" && eresult != "1" {
+		return fmt.Errorf("steamcommunity: %s failed (EResult %s)", method, eresult)
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	return json.Unmarshal(body, v)
+}
+
+// quickInviteLink converts a token from the Web API to a QuickInviteLink owned by the logged in user.
+func (c *Client) quickInviteLink(token quickInviteToken) (*QuickInviteLink, error) {
+	accountID, err := steamIDToAccountID(c.SteamID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	link := &QuickInviteLink{
+		URL:   fmt.Sprintf("https://s.team/p/%s/%s", encodeQuickInviteCode(accountID), token.InviteToken),
+		Token: token.InviteToken,
+		Valid: token.Valid,
+	}
+
+	link.Limit, _ = strconv.Atoi(token.InviteLimit)
+
+	if seconds, _ := strconv.Atoi(token.InviteDuration); seconds > 0 {
+		link.Duration = time.Duration(seconds) * time.Second
+	}
+
+	if token.TimeCreated > 0 {
+		link.Created = time.Unix(token.TimeCreated, 0)
+	}
+
+	return link, nil
+}
+
+// parseQuickInviteLink returns the SteamID64 of the user who created a quick invite link, and its token.
+func parseQuickInviteLink(link string) (string, string, error) {
+	match := quickInviteLinkRegexp.FindStringSubmatch(link)
+
+	if match == nil {
+		return "", "", ErrorInvalidQuickInviteLink
+	}
+
+	accountID, err := decodeQuickInviteCode(match[1])
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return accountIDToSteamID(accountID), match[2], nil
+}
+
+// encodeQuickInviteCode encodes an account ID as the short code in a quick invite link.
+// The account ID's hex digits are replaced using quickInviteAlphabet, and codes longer than 3 characters are split in half with a dash.
+func encodeQuickInviteCode(accountID uint32) string {
+	hex := strconv.FormatUint(uint64(accountID), 16)
+
+	code := make([]byte, len(hex))
+	for i := 0; i < len(hex); i++ {
+		digit, _ := strconv.ParseUint(hex[i:i+1], 16, 8)
+		code[i] = quickInviteAlphabet[digit]
+	}
+
+	if len(code) > 3 {
+		half := len(code) / 2
+		return string(code[:half]) + "-" + string(code[half:])
+	}
+
+	return string(code)
+}
+
+// decodeQuickInviteCode decodes the short code in a quick invite link to an account ID.
+func decodeQuickInviteCode(code string) (uint32, error) {
+	code = strings.Replace(code, "-", "", -1)
+
+	if code == "" || len(code) > 8 {
+		return 0, ErrorInvalidQuickInviteLink
+	}
+
+	var accountID uint32
+	for _, r := range code {
+		digit := strings.IndexRune(quickInviteAlphabet, r)
+
+		if digit < 0 {
+			return 0, ErrorInvalidQuickInviteLink
+		}
+
+		accountID = accountID<<4 | uint32(digit)
+	}
+
+	return accountID, nil
+}
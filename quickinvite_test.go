@@ -0,0 +1,92 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestCreateQuickInviteLink() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Eresult", "1")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response":{"invite_token":"tf2bot42","invite_limit":"1","invite_duration":"2592000","time_created":1500000000,"valid":true}}`))
+	})
+
+	link, err := s.Client.CreateQuickInviteLink(1, 30*24*time.Hour)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &steamcommunity.QuickInviteLink{
+		URL:      "https://s.team/p/jdq-vvdf/tf2bot42",
+		Token:    "tf2bot42",
+		Limit:    1,
+		Duration: 30 * 24 * time.Hour,
+		Created:  time.Unix(1500000000, 0),
+		Valid:    true,
+	}, link)
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "/IUserAccountService/CreateFriendInviteToken/v1/", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "1", form.Get("invite_limit"))
+	assert.Equal(s.T(), "2592000", form.Get("invite_duration"))
+	assert.NotEmpty(s.T(), form.Get("access_token"))
+}
+
+func (s *ClientTestSuite) TestListQuickInviteLinks() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response":{"tokens":[{"invite_token":"tf2bot42","invite_limit":"1","time_created":1500000000,"valid":true},{"invite_token":"expired1","valid":false}]}}`))
+	})
+
+	links, err := s.Client.ListQuickInviteLinks()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), links, 2)
+	assert.Equal(s.T(), "https://s.team/p/jdq-vvdf/tf2bot42", links[0].URL)
+	assert.Equal(s.T(), time.Duration(0), links[0].Duration)
+	assert.False(s.T(), links[1].Valid)
+}
+
+func (s *ClientTestSuite) TestRevokeQuickInviteLink() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Eresult", "1")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response":{}}`))
+	})
+
+	assert.NoError(s.T(), s.Client.RevokeQuickInviteLink("https://s.team/p/jdq-vvdf/tf2bot42"))
+	assert.Equal(s.T(), "tf2bot42", s.lastForm().Get("invite_token"))
+
+	assert.NoError(s.T(), s.Client.RevokeQuickInviteLink("tf2bot43"))
+	assert.Equal(s.T(), "tf2bot43", s.lastForm().Get("invite_token"))
+}
+
+func (s *ClientTestSuite) TestRedeemQuickInviteLink() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Eresult", "1")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response":{}}`))
+	})
+
+	assert.NoError(s.T(), s.Client.RedeemQuickInviteLink("https://s.team/p/jdq-vvdg/tf2bot42"))
+
+	form := s.lastForm()
+	assert.Equal(s.T(), "/IUserAccountService/RedeemFriendInviteToken/v1/", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "76561198063808036", form.Get("steamid"))
+	assert.Equal(s.T(), "tf2bot42", form.Get("invite_token"))
+
+	err := s.Client.RedeemQuickInviteLink("https://example.com/p/jdq-vvdg/tf2bot42")
+	assert.Equal(s.T(), steamcommunity.ErrorInvalidQuickInviteLink, err)
+}
+
+func (s *ClientTestSuite) TestRedeemQuickInviteLinkFailed() {
+	s.login(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Eresult", "15")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response":{}}`))
+	})
+
+	err := s.Client.RedeemQuickInviteLink("s.team/p/jdq-vvdg/tf2bot42")
+	assert.EqualError(s.T(), err, "steamcommunity: RedeemFriendInviteToken failed (EResult 15)")
+}
@@ -0,0 +1,25 @@
+package steamcommunity
+
+import (
+	"errors"
+	"strconv"
+)
+
+// steamIDIndividualBase is the SteamID64 of the individual account with account ID 0.
+const steamIDIndividualBase = 76561197960265728
+
+// accountIDToSteamID converts the 32 bit account ID of an individual account, as used in Steam's miniprofile attributes, to a SteamID64.
+func accountIDToSteamID(accountID uint32) string {
+	return strconv.FormatUint(steamIDIndividualBase+uint64(accountID), 10)
+}
+
+// steamIDToAccountID converts the SteamID64 of an individual account to its 32 bit account ID.
+func steamIDToAccountID(steamID string) (uint32, error) {
+	id, err := strconv.ParseUint(steamID, 10, 64)
+
+	if err != nil || id < steamIDIndividualBase || id-steamIDIndividualBase > 0xFFFFFFFF {
+		return 0, errors.New("steamcommunity: Invalid SteamID")
+	}
+
+	return uint32(id - steamIDIndividualBase), nil
+}
@@ -0,0 +1,170 @@
+package steamcommunity
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	userGroupIDRegexp      = regexp.MustCompile(`id="group_(\d+)"`)
+	userGroupLinkRegexp    = regexp.MustCompile(`(?s)<a class="linkTitle" href="([^"]+)"[^>]*>(.*?)</a>`)
+	userGroupPrimaryRegexp = regexp.MustCompile(`class="primary_group"`)
+)
+
+// UserGroup is a Steam Group a user belongs to, or has been invited to.
+type UserGroup struct {
+	ID      string
+	Name    string
+	URL     string
+	Primary bool
+}
+
+type friendActionResponse struct {
+	Success steamBool `json:"success"`
+}
+
+// JoinGroup joins the group with the given SteamID64.
+func (c *Client) JoinGroup(groupID string) error {
+	resp, err := c.postForm(
+		fmt.Sprintf("https://steamcommunity.com/gid/%s", groupID),
+		map[string]string{},
+		map[string]string{
+			"sessionID": c.SessionID,
+			"action":    "join",
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// LeaveGroup leaves the group with the given SteamID64.
+func (c *Client) LeaveGroup(groupID string) error {
+	return c.homeProcess("leaveGroup", groupID)
+}
+
+// SetPrimaryGroup sets the group with the given SteamID64 as the primary group shown on the logged in user's profile.
+func (c *Client) SetPrimaryGroup(groupID string) error {
+	return c.homeProcess("setPrimaryGroup", groupID)
+}
+
+// MyGroups retrieves the groups the logged in user is a member of.
+func (c *Client) MyGroups() ([]*UserGroup, error) {
+	return c.userGroups(fmt.Sprintf("https://steamcommunity.com/profiles/%s/groups/", c.SteamID))
+}
+
+// GroupInvites retrieves the groups the logged in user has been invited to.
+func (c *Client) GroupInvites() ([]*UserGroup, error) {
+	return c.userGroups(fmt.Sprintf("https://steamcommunity.com/profiles/%s/groups/pending?ajax=1", c.SteamID))
+}
+
+// AcceptGroupInvite accepts an invitation to the group with the given SteamID64.
+func (c *Client) AcceptGroupInvite(groupID string) error {
+	return c.friendAction("group_accept", []string{groupID})
+}
+
+// DeclineGroupInvite declines an invitation to the group with the given SteamID64.
+func (c *Client) DeclineGroupInvite(groupID string) error {
+	return c.friendAction("group_ignore", []string{groupID})
+}
+
+// homeProcess sends a group action to the logged in user's profile.
+func (c *Client) homeProcess(action string, groupID string) error {
+	resp, err := c.postForm(
+		fmt.Sprintf("https://steamcommunity.com/profiles/%s/home_process", c.SteamID),
+		map[string]string{},
+		map[string]string{
+			"sessionID": c.SessionID,
+			"action":    action,
+			"groupId":   groupID,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+// friendAction sends an action for the given SteamIDs to the logged in user's friends page.
+// This is used for both friend and group invites.
+func (c *Client) friendAction(action string, steamIDs []string) error {
+	values := url.Values{}
+	values.Set("sessionid", c.SessionID)
+	values.Set("steamid", c.SteamID)
+	values.Set("ajax", "1")
+	values.Set("action", action)
+
+	for _, steamID := range steamIDs {
+		values.Add("steamids[]", steamID)
+	}
+
+	var actionResp friendActionResponse
+	err := c.postValuesJSON(
+		fmt.Sprintf("https://steamcommunity.com/profiles/%s/friends/action", c.SteamID),
+		values,
+		&actionResp,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if !actionResp.Success {
+		return errors.New("steamcommunity: Friend action failed")
+	}
+
+	return nil
+}
+
+// userGroups retrieves and parses a list of group blocks.
+func (c *Client) userGroups(uri string) ([]*UserGroup, error) {
+	resp, err := c.get(uri)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	var groups []*UserGroup
+	for _, block := range strings.Split(string(body), `class="group_block`)[1:] {
+		match := userGroupIDRegexp.FindStringSubmatch(block)
+
+		if match == nil {
+			continue
+		}
+
+		group := &UserGroup{
+			ID:      match[1],
+			Primary: userGroupPrimaryRegexp.MatchString(block),
+		}
+
+		if match := userGroupLinkRegexp.FindStringSubmatch(block); match != nil {
+			group.URL = match[1]
+			group.Name = htmlToText(match[2])
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
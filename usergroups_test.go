@@ -0,0 +1,120 @@
+package steamcommunity_test
+
+import (
+	"net/http"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestMyGroups() {
+	s.login(
+		// Groups request.
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(s.T(), "/profiles/76561198063808035/groups/", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<div class="group_block invite_row" id="group_103582791454641428">
+					<a class="linkTitle" href="https://steamcommunity.com/groups/shival">shival</a>
+					<span class="primary_group">Primary Group</span>
+				</div>
+				<div class="group_block invite_row" id="group_103582791429521412">
+					<a class="linkTitle" href="https://steamcommunity.com/groups/valve">Valve</a>
+				</div>
+			`))
+		},
+	)
+
+	groups, err := s.Client.MyGroups()
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*steamcommunity.UserGroup{
+		{ID: "103582791454641428", Name: "shival", URL: "https://steamcommunity.com/groups/shival", Primary: true},
+		{ID: "103582791429521412", Name: "Valve", URL: "https://steamcommunity.com/groups/valve"},
+	}, groups)
+}
+
+func (s *ClientTestSuite) TestAcceptGroupInvite() {
+	s.login(
+		// Invite response.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1}`))
+		},
+	)
+
+	err := s.Client.AcceptGroupInvite("103582791454641428")
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/profiles/76561198063808035/friends/action", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "group_accept", form.Get("action"))
+	assert.Equal(s.T(), []string{"103582791454641428"}, form["steamids[]"])
+}
+
+func (s *ClientTestSuite) TestDeclineGroupInvite() {
+	s.login(
+		// Invite response.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success":1}`))
+		},
+	)
+
+	err := s.Client.DeclineGroupInvite("103582791454641428")
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/profiles/76561198063808035/friends/action", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "group_ignore", form.Get("action"))
+	assert.Equal(s.T(), []string{"103582791454641428"}, form["steamids[]"])
+}
+
+func (s *ClientTestSuite) TestJoinGroup() {
+	s.login(
+		// Join response.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+
+	err := s.Client.JoinGroup("103582791454641428")
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/gid/103582791454641428", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "join", form.Get("action"))
+	assert.Equal(s.T(), s.Client.SessionID, form.Get("sessionID"))
+}
+
+func (s *ClientTestSuite) TestJoinGroupError() {
+	s.login(
+		// Join response.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		},
+	)
+
+	err := s.Client.JoinGroup("103582791454641428")
+
+	assert.Equal(s.T(), steamcommunity.ErrorNotLoggedIn, err)
+}
+
+func (s *ClientTestSuite) TestLeaveGroup() {
+	s.login(
+		// Leave response.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+	)
+
+	err := s.Client.LeaveGroup("103582791454641428")
+
+	form := s.lastForm()
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "/profiles/76561198063808035/home_process", s.LastRequest.URL.Path)
+	assert.Equal(s.T(), "leaveGroup", form.Get("action"))
+	assert.Equal(s.T(), "103582791454641428", form.Get("groupId"))
+	assert.Equal(s.T(), s.Client.SessionID, form.Get("sessionID"))
+}
@@ -1,11 +1,59 @@
 package steamcommunity
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
+var (
+	htmlBreakRegexp = regexp.MustCompile(`(?i)<br\s*/?>`)
+	htmlTagRegexp   = regexp.MustCompile(`(?s)<[^>]*>`)
+
+	formRegexp         = regexp.MustCompile(`(?s)<form\b[^>]*\sid="([^"]*)"[^>]*>(.*?)</form>`)
+	formInputRegexp    = regexp.MustCompile(`(?s)<input\b[^>]*>`)
+	formTextareaRegexp = regexp.MustCompile(`(?s)<textarea\b[^>]*\sname="([^"]+)"[^>]*>(.*?)</textarea>`)
+	formSelectRegexp   = regexp.MustCompile(`(?s)<select\b[^>]*\sname="([^"]+)"[^>]*>(.*?)</select>`)
+	formOptionRegexp   = regexp.MustCompile(`(?s)<option\b[^>]*\sselected\b[^>]*>`)
+	formAttrRegexp     = regexp.MustCompile(`\s(name|value|type)="([^"]*)"`)
+	formCheckedRegexp  = regexp.MustCompile(`\schecked\b`)
+)
+
+// steamDateLayouts are the date formats used on Steam Community pages, depending on the account's language.
+var steamDateLayouts = []string{
+	"January 2, 2006",
+	"2 January, 2006",
+	"Jan 2, 2006",
+	"2 Jan, 2006",
+}
+
+// steamDateNoYearLayouts are used by Steam for dates in the current year.
+var steamDateNoYearLayouts = []string{
+	"January 2",
+	"2 January",
+	"Jan 2",
+	"2 Jan",
+}
+
+// steamTimeLayouts are the date and time formats used on Steam Community pages.
+var steamTimeLayouts = []string{
+	"Jan 2, 2006 @ 3:04pm",
+	"2 Jan, 2006 @ 3:04pm",
+}
+
+// steamTimeNoYearLayouts are used by Steam for times in the current year.
+var steamTimeNoYearLayouts = []string{
+	"Jan 2 @ 3:04pm",
+	"2 Jan @ 3:04pm",
+}
+
 func generateSessionID() (string, error) {
 	b := make([]byte, 12)
 	n, err := rand.Read(b)
@@ -14,3 +62,170 @@ func generateSessionID() (string, error) {
 	}
 	return hex.EncodeToString(b), nil
 }
+
+// htmlToText converts a fragment of Steam Community HTML into plain text.
+func htmlToText(s string) string {
+	s = htmlBreakRegexp.ReplaceAllString(s, "\n")
+	s = htmlTagRegexp.ReplaceAllString(s, "")
+	return strings.TrimSpace(html.UnescapeString(s))
+}
+
+// unwrapLinkFilter returns the destination of a Steam linkfilter URL, or uri unchanged if it is not one.
+func unwrapLinkFilter(uri string) string {
+	u, err := url.Parse(uri)
+
+	if err != nil || !strings.HasPrefix(u.Path, "/linkfilter") {
+		return uri
+	}
+
+	if target := u.Query().Get("url"); target != "" {
+		return target
+	}
+
+	return uri
+}
+
+// parseSteamDate parses a date as shown on Steam Community pages.
+func parseSteamDate(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range steamDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	for _, layout := range steamDateNoYearLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t.AddDate(time.Now().In(loc).Year(), 0, 0), nil
+		}
+	}
+
+	return time.Time{}, errors.New("steamcommunity: Unrecognised date format")
+}
+
+// parseSteamTime parses a date and time as shown on Steam Community pages, such as "Oct 14 @ 10:47pm".
+// Times without a year are assumed to be within the year before now.
+func parseSteamTime(s string, loc *time.Location, now time.Time) (time.Time, error) {
+	s = strings.Join(strings.Fields(s), " ")
+
+	for _, layout := range steamTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	for _, layout := range steamTimeNoYearLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			t = t.AddDate(now.In(loc).Year(), 0, 0)
+			if t.After(now) {
+				t = t.AddDate(-1, 0, 0)
+			}
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("steamcommunity: Unrecognised time format")
+}
+
+// boolToForm converts a bool to the "1" or "0" Steam expects in forms.
+func boolToForm(b bool) string {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
+
+// steamBool is a bool in a Steam JSON response, which may be sent as either a bool or a number.
+type steamBool bool
+
+func (b *steamBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", "1":
+		*b = true
+	case "false", "0", "null":
+		*b = false
+	default:
+		n, err := strconv.Atoi(string(data))
+		if err != nil {
+			return errors.New("steamcommunity: Malformed boolean in response")
+		}
+		*b = n > 0
+	}
+
+	return nil
+}
+
+// runEvery calls check immediately and then every interval until ctx is done, returning ctx.Err().
+// Errors from check are passed to onError if it is not nil, unless ctx is done, and do not stop the loop.
+func runEvery(ctx context.Context, interval time.Duration, check func() error, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := check(); err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// parseFormValues returns the current values of the fields of the form with the given id in page, as a browser would submit them.
+// ok is false if page has no such form.
+func parseFormValues(page string, id string) (values url.Values, ok bool) {
+	for _, match := range formRegexp.FindAllStringSubmatch(page, -1) {
+		if match[1] == id {
+			page, ok = match[2], true
+			break
+		}
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	values = url.Values{}
+
+	for _, input := range formInputRegexp.FindAllString(page, -1) {
+		attrs := map[string]string{}
+		for _, match := range formAttrRegexp.FindAllStringSubmatch(input, -1) {
+			attrs[match[1]] = html.UnescapeString(match[2])
+		}
+
+		if attrs["name"] == "" {
+			continue
+		}
+
+		switch attrs["type"] {
+		case "checkbox", "radio":
+			if !formCheckedRegexp.MatchString(input) {
+				continue
+			}
+		case "submit", "button", "file", "image":
+			continue
+		}
+
+		values.Add(attrs["name"], attrs["value"])
+	}
+
+	for _, match := range formTextareaRegexp.FindAllStringSubmatch(page, -1) {
+		values.Add(match[1], html.UnescapeString(match[2]))
+	}
+
+	for _, match := range formSelectRegexp.FindAllStringSubmatch(page, -1) {
+		if option := formOptionRegexp.FindString(match[2]); option != "" {
+			for _, attr := range formAttrRegexp.FindAllStringSubmatch(option, -1) {
+				if attr[1] == "value" {
+					values.Add(match[1], html.UnescapeString(attr[2]))
+				}
+			}
+		}
+	}
+
+	return values, true
+}
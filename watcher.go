@@ -0,0 +1,243 @@
+package steamcommunity
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrorWatcherRunning = errors.New("steamcommunity: GroupWatcher is already running")
+	ErrorWatcherNoGroup = errors.New("steamcommunity: GroupWatcher must be created with NewGroupWatcher")
+)
+
+// GroupWatchEventType is the type of a change seen by a GroupWatcher.
+type GroupWatchEventType int
+
+const (
+	GroupWatchJoined GroupWatchEventType = iota
+	GroupWatchLeft
+	GroupWatchOnlineChanged
+	GroupWatchInGameChanged
+)
+
+// GroupWatchEvent is a change to a group seen by a GroupWatcher.
+type GroupWatchEvent struct {
+	Type GroupWatchEventType
+	Time time.Time
+	// SteamID is the member who joined or left.
+	SteamID string
+	// Previous and Current are the old and new counts for GroupWatchOnlineChanged and GroupWatchInGameChanged.
+	Previous int
+	Current  int
+}
+
+// GroupSnapshot is the state of a group at a point in time.
+type GroupSnapshot struct {
+	GroupID       string
+	Time          time.Time
+	Members       []string
+	MembersOnline int
+	MembersInGame int
+}
+
+// GroupSnapshotStore persists the snapshots a GroupWatcher compares against.
+type GroupSnapshotStore interface {
+	// Load returns the last saved snapshot of the group, or nil if there is none.
+	Load(groupID string) (*GroupSnapshot, error)
+	Save(snapshot *GroupSnapshot) error
+}
+
+// MemorySnapshotStore is a GroupSnapshotStore that keeps snapshots in memory. The zero value is ready to use.
+type MemorySnapshotStore struct {
+	mu        sync.Mutex
+	snapshots map[string]*GroupSnapshot
+}
+
+func (s *MemorySnapshotStore) Load(groupID string) (*GroupSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.snapshots[groupID], nil
+}
+
+func (s *MemorySnapshotStore) Save(snapshot *GroupSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.snapshots == nil {
+		s.snapshots = make(map[string]*GroupSnapshot)
+	}
+
+	s.snapshots[snapshot.GroupID] = snapshot
+
+	return nil
+}
+
+// GroupWatcher periodically snapshots a group's members, sending an event for every change since the last snapshot.
+// It must be created with NewGroupWatcher.
+type GroupWatcher struct {
+	group   *Group
+	store   GroupSnapshotStore
+	options *MembersOptions
+
+	mu      sync.Mutex
+	running bool
+	events  chan GroupWatchEvent
+}
+
+// NewGroupWatcher creates a GroupWatcher for group. If store is nil, snapshots are kept in memory.
+// opts configures how the member list is walked, and may be nil to use the defaults.
+func NewGroupWatcher(group *Group, store GroupSnapshotStore, opts *MembersOptions) *GroupWatcher {
+	if store == nil {
+		store = &MemorySnapshotStore{}
+	}
+
+	return &GroupWatcher{
+		// The watcher only needs the group's ID, so it keeps its own copy rather than sharing the caller's.
+		group:   &Group{ID: group.ID, client: group.client},
+		store:   store,
+		options: opts,
+	}
+}
+
+// Events returns the channel Run sends changes on. It is closed when Run returns.
+func (w *GroupWatcher) Events() <-chan GroupWatchEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.eventsLocked()
+}
+
+// eventsLocked returns the events channel, creating it if needed. w.mu must be held.
+func (w *GroupWatcher) eventsLocked() chan GroupWatchEvent {
+	if w.events == nil {
+		w.events = make(chan GroupWatchEvent)
+	}
+
+	return w.events
+}
+
+// Check takes a snapshot of the group's member list, returning an event for every change since the previous snapshot.
+// No events are returned for the first snapshot of a group. The group the watcher was created with is not modified.
+func (w *GroupWatcher) Check(ctx context.Context) ([]GroupWatchEvent, error) {
+	if w.group == nil {
+		return nil, ErrorWatcherNoGroup
+	}
+
+	// The online and in game counts come from the first page of the member list, so the group isn't retrieved separately.
+	it := w.group.membersIter(ctx, w.options, false)
+	defer it.Close()
+
+	var members []string
+	for it.Next() {
+		members = append(members, it.SteamID())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(members)
+	snapshot := &GroupSnapshot{
+		GroupID:       w.group.ID,
+		Time:          time.Now(),
+		Members:       members,
+		MembersOnline: it.details.MembersOnline,
+		MembersInGame: it.details.MembersInGame,
+	}
+
+	previous, err := w.store.Load(snapshot.GroupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var events []GroupWatchEvent
+	if previous != nil {
+		events = diffGroupSnapshots(previous, snapshot)
+	}
+
+	if err = w.store.Save(snapshot); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// Run sends the changes found by Check on the Events channel every interval until ctx is done, then closes it.
+// Errors are passed to onError if it is not nil. Run can only be called once, and returns ErrorWatcherRunning after that.
+func (w *GroupWatcher) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return ErrorWatcherRunning
+	}
+
+	w.running = true
+	events := w.eventsLocked()
+	w.mu.Unlock()
+
+	defer close(events)
+
+	return runEvery(ctx, interval, func() error {
+		changes, err := w.Check(ctx)
+
+		for _, event := range changes {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		return err
+	}, onError)
+}
+
+// diffGroupSnapshots returns the events for every change between two snapshots.
+func diffGroupSnapshots(previous *GroupSnapshot, current *GroupSnapshot) []GroupWatchEvent {
+	var events []GroupWatchEvent
+
+	before := make(map[string]bool, len(previous.Members))
+	for _, id := range previous.Members {
+		before[id] = true
+	}
+
+	after := make(map[string]bool, len(current.Members))
+	for _, id := range current.Members {
+		after[id] = true
+
+		if !before[id] {
+			events = append(events, GroupWatchEvent{Type: GroupWatchJoined, Time: current.Time, SteamID: id})
+		}
+	}
+
+	for _, id := range previous.Members {
+		if !after[id] {
+			events = append(events, GroupWatchEvent{Type: GroupWatchLeft, Time: current.Time, SteamID: id})
+		}
+	}
+
+	if previous.MembersOnline != current.MembersOnline {
+		events = append(events, GroupWatchEvent{
+			Type:     GroupWatchOnlineChanged,
+			Time:     current.Time,
+			Previous: previous.MembersOnline,
+			Current:  current.MembersOnline,
+		})
+	}
+
+	if previous.MembersInGame != current.MembersInGame {
+		events = append(events, GroupWatchEvent{
+			Type:     GroupWatchInGameChanged,
+			Time:     current.Time,
+			Previous: previous.MembersInGame,
+			Current:  current.MembersInGame,
+		})
+	}
+
+	return events
+}
@@ -0,0 +1,121 @@
+package steamcommunity_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	steamcommunity "alex-j-butler.com/steamcommunity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestGroupWatcher() {
+	s.login(
+		groupResponse,
+		groupResponse,
+
+		// Member list request.
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`
+				<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+				<memberList>
+					<groupID64>103582791454641428</groupID64>
+					<groupDetails>
+						<groupName><![CDATA[shival]]></groupName>
+						<groupURL><![CDATA[shival]]></groupURL>
+						<memberCount>2</memberCount>
+						<membersInGame>1</membersInGame>
+						<membersOnline>2</membersOnline>
+					</groupDetails>
+					<memberCount>2</memberCount>
+					<totalPages>1</totalPages>
+					<currentPage>1</currentPage>
+					<members>
+						<steamID64>76561198063808035</steamID64>
+						<steamID64>76561198333828103</steamID64>
+					</members>
+				</memberList>
+			`))
+		},
+	)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	store := &steamcommunity.MemorySnapshotStore{}
+	store.Save(&steamcommunity.GroupSnapshot{
+		GroupID:       "103582791454641428",
+		Members:       []string{"76561198000000001", "76561198063808035"},
+		MembersOnline: 1,
+		MembersInGame: 1,
+	})
+
+	before := *group
+	watcher := steamcommunity.NewGroupWatcher(group, store, nil)
+	events, err := watcher.Check(context.Background())
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), events, 3)
+	assert.Equal(s.T(), steamcommunity.GroupWatchJoined, events[0].Type)
+	assert.Equal(s.T(), "76561198333828103", events[0].SteamID)
+	assert.Equal(s.T(), steamcommunity.GroupWatchLeft, events[1].Type)
+	assert.Equal(s.T(), "76561198000000001", events[1].SteamID)
+	assert.Equal(s.T(), steamcommunity.GroupWatchOnlineChanged, events[2].Type)
+	assert.Equal(s.T(), 1, events[2].Previous)
+	assert.Equal(s.T(), 2, events[2].Current)
+
+	snapshot, err := store.Load("103582791454641428")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{"76561198063808035", "76561198333828103"}, snapshot.Members)
+	assert.Equal(s.T(), 2, snapshot.MembersOnline)
+	assert.Contains(s.T(), s.LastRequest.URL.Path, "/memberslistxml/")
+
+	// The caller's group is not modified.
+	assert.Equal(s.T(), before, *group)
+
+	// Nothing has changed since the last snapshot.
+	events, err = watcher.Check(context.Background())
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), events)
+}
+
+func (s *ClientTestSuite) TestGroupWatcherRun() {
+	s.login(groupResponse, groupResponse, groupResponse)
+
+	group, err := s.Client.Group("shival")
+	assert.NoError(s.T(), err)
+
+	store := &steamcommunity.MemorySnapshotStore{}
+	store.Save(&steamcommunity.GroupSnapshot{GroupID: "103582791454641428"})
+
+	watcher := steamcommunity.NewGroupWatcher(group, store, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx, time.Hour, nil)
+	}()
+
+	event := <-watcher.Events()
+	cancel()
+
+	assert.Equal(s.T(), steamcommunity.GroupWatchJoined, event.Type)
+	assert.Equal(s.T(), context.Canceled, <-done)
+
+	for range watcher.Events() {
+	}
+
+	// The events channel has been closed, so the watcher can't run again.
+	assert.Equal(s.T(), steamcommunity.ErrorWatcherRunning, watcher.Run(context.Background(), time.Hour, nil))
+}
+
+func (s *ClientTestSuite) TestGroupWatcherZeroValue() {
+	watcher := &steamcommunity.GroupWatcher{}
+
+	_, err := watcher.Check(context.Background())
+	assert.Equal(s.T(), steamcommunity.ErrorWatcherNoGroup, err)
+}